@@ -0,0 +1,119 @@
+package adobesign
+
+import (
+	"context"
+	"errors"
+	"net/http"
+)
+
+// AccountService handles operations related to the account the client is authenticated for. Adobe Sign has no
+// account endpoint, so the account information is assembled from the calling user, the default group of the account
+// and the settings of that group.
+//
+// The REST API does not expose the name of the account, its limits, nor whether notarization, knowledge based
+// authentication or digital signatures are enabled, so they are not part of Account. Limits follow from Type.
+type AccountService service
+
+// AccountFeatures lists what the users of the account can do, as set on the default group of the account.
+type AccountFeatures struct {
+	// LibraryDocumentCreation True if users can create library documents
+	LibraryDocumentCreation bool
+	// SendRestrictedToWorkflows True if users can only send agreements through workflows
+	SendRestrictedToWorkflows bool
+	// WidgetCreation True if users can create web forms
+	WidgetCreation bool
+}
+
+// Account describes the account the client is authenticated for.
+type Account struct {
+	// Id The unique identifier of the account
+	Id string
+	// Type ['FREE' or 'PRO' or 'TEAM' or 'TEAM_TRIAL' or 'ENTERPRISE' or 'ENTERPRISE_TRIAL' or 'GLOBAL' or
+	//'GLOBAL_TRIAL']: Type of the account, which determines its limits
+	Type string
+	// DefaultGroupId The unique identifier of the default group of the account, empty if the calling user may not
+	//list the groups of the account
+	DefaultGroupId string
+	// DefaultGroupName The name of the default group of the account
+	DefaultGroupName string
+	// FeaturesKnown True if Features were read from the settings of the default group. When false the calling user
+	//may not read them and Features does not say whether a feature is disabled
+	FeaturesKnown bool
+	// Features The features enabled for the account
+	Features AccountFeatures
+	// Settings The settings of the default group of the account, nil if the calling user may not read them
+	Settings *GroupSettings
+}
+
+// GetAccount retrieves the account of the calling user, which is the impersonated user if any. Listing the groups
+// of the account and reading their settings requires an account admin, so for other users only the id and type of
+// the account are returned, the default group and Settings are left empty and FeaturesKnown is false.
+func (s *AccountService) GetAccount(ctx context.Context) (*Account, error) {
+	user, err := (*UserService)(s).GetCurrentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	account := &Account{
+		Id:   user.AccountId,
+		Type: user.AccountType,
+	}
+
+	groups := (*GroupService)(s)
+	opts := &ListOptions{}
+	for account.DefaultGroupId == "" {
+		page, err := groups.ListGroups(ctx, opts)
+		if isPermissionDenied(err) {
+			return account, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		for _, group := range page.GroupInfoList {
+			if group.IsDefaultGroup {
+				account.DefaultGroupId = group.GroupId
+				account.DefaultGroupName = group.GroupName
+				break
+			}
+		}
+
+		if account.DefaultGroupId != "" || page.Page.NextCursor == "" {
+			break
+		}
+		if page.Page.NextCursor == opts.Cursor {
+			return account, ErrPaginationStalled
+		}
+		opts.Cursor = page.Page.NextCursor
+	}
+
+	if account.DefaultGroupId == "" {
+		return account, nil
+	}
+
+	settings, err := groups.GetGroupSettings(ctx, account.DefaultGroupId)
+	if isPermissionDenied(err) {
+		return account, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	account.Settings = settings
+	account.FeaturesKnown = true
+	account.Features = AccountFeatures{
+		LibraryDocumentCreation:   settings.LibraryDocumentCreationVisible.Enabled(),
+		SendRestrictedToWorkflows: settings.SendRestrictedToWorkflows.Enabled(),
+		WidgetCreation:            settings.WidgetCreationVisible.Enabled(),
+	}
+
+	return account, nil
+}
+
+// isPermissionDenied reports whether err is an API error caused by the calling user lacking the required scope or role.
+func isPermissionDenied(err error) bool {
+	var errorResponse *ErrorResponse
+	if !errors.As(err, &errorResponse) || errorResponse.Response == nil {
+		return false
+	}
+	return errorResponse.Response.StatusCode == http.StatusForbidden
+}
@@ -0,0 +1,115 @@
+package adobesign
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+const permissionDeniedResponse = `{"code":"PERMISSION_DENIED","message":"The API caller does not have the permission to execute this operation."}`
+
+func TestGetAccount(t *testing.T) {
+	tests := []struct {
+		name         string
+		groups       func(w http.ResponseWriter, r *http.Request)
+		settings     func(w http.ResponseWriter, r *http.Request)
+		wantGroupId  string
+		wantSettings bool
+		wantFeatures AccountFeatures
+		wantErr      error
+	}{
+		{
+			name: "account admin",
+			groups: func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Query().Get("cursor") == "" {
+					fmt.Fprint(w, `{"groupInfoList":[{"groupId":"g1","groupName":"Sales"}],"page":{"nextCursor":"c1"}}`)
+					return
+				}
+				fmt.Fprint(w, `{"groupInfoList":[{"groupId":"g2","groupName":"Default Group","isDefaultGroup":true}],"page":{}}`)
+			},
+			settings: func(w http.ResponseWriter, r *http.Request) {
+				fmt.Fprint(w, groupSettingsResponse)
+			},
+			wantGroupId:  "g2",
+			wantSettings: true,
+			wantFeatures: AccountFeatures{LibraryDocumentCreation: true, WidgetCreation: true},
+		},
+		{
+			name: "groups not visible",
+			groups: func(w http.ResponseWriter, r *http.Request) {
+				http.Error(w, permissionDeniedResponse, http.StatusForbidden)
+			},
+		},
+		{
+			name: "settings not visible",
+			groups: func(w http.ResponseWriter, r *http.Request) {
+				fmt.Fprint(w, `{"groupInfoList":[{"groupId":"g2","groupName":"Default Group","isDefaultGroup":true}],"page":{}}`)
+			},
+			settings: func(w http.ResponseWriter, r *http.Request) {
+				http.Error(w, permissionDeniedResponse, http.StatusForbidden)
+			},
+			wantGroupId: "g2",
+		},
+		{
+			name: "repeated cursor",
+			groups: func(w http.ResponseWriter, r *http.Request) {
+				fmt.Fprint(w, `{"groupInfoList":[{"groupId":"g1","groupName":"Sales"}],"page":{"nextCursor":"c1"}}`)
+			},
+			wantErr: ErrPaginationStalled,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client, mux := setup(t)
+			mux.HandleFunc("/users/me", func(w http.ResponseWriter, r *http.Request) {
+				fmt.Fprint(w, `{"id":"u1","email":"jane@example.com","accountId":"a1","accountType":"ENTERPRISE","company":"Acme"}`)
+			})
+			mux.HandleFunc("/groups", tt.groups)
+			if tt.settings != nil {
+				mux.HandleFunc("/groups/"+tt.wantGroupId+"/settings", tt.settings)
+			}
+
+			account, err := client.AccountService.GetAccount(context.Background())
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("GetAccount() error = %v, want %v", err, tt.wantErr)
+			}
+			if account == nil {
+				t.Fatal("GetAccount() account = nil")
+			}
+			if account.Id != "a1" || account.Type != "ENTERPRISE" {
+				t.Errorf("GetAccount() id, type = %q, %q, want a1, ENTERPRISE", account.Id, account.Type)
+			}
+			if account.DefaultGroupId != tt.wantGroupId {
+				t.Errorf("GetAccount() default group = %q, want %q", account.DefaultGroupId, tt.wantGroupId)
+			}
+			if (account.Settings != nil) != tt.wantSettings {
+				t.Errorf("GetAccount() settings = %+v, want settings %v", account.Settings, tt.wantSettings)
+			}
+			if account.FeaturesKnown != tt.wantSettings {
+				t.Errorf("GetAccount() FeaturesKnown = %v, want %v", account.FeaturesKnown, tt.wantSettings)
+			}
+			if account.Features != tt.wantFeatures {
+				t.Errorf("GetAccount() features = %+v, want %+v", account.Features, tt.wantFeatures)
+			}
+		})
+	}
+}
+
+func TestGetAccountUserError(t *testing.T) {
+	client, mux := setup(t)
+	mux.HandleFunc("/users/me", func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, `{"code":"INVALID_ACCESS_TOKEN","message":"Access token provided is invalid or has expired"}`, http.StatusUnauthorized)
+	})
+
+	account, err := client.AccountService.GetAccount(context.Background())
+	var errorResponse *ErrorResponse
+	if !errors.As(err, &errorResponse) || errorResponse.Code != "INVALID_ACCESS_TOKEN" {
+		t.Fatalf("GetAccount() error = %v, want the INVALID_ACCESS_TOKEN error", err)
+	}
+	if account != nil {
+		t.Errorf("GetAccount() account = %+v, want nil", account)
+	}
+}
@@ -29,10 +29,17 @@ const (
 	headerRateLimit = "Retry-After"
 
 	headerApiVersion = "Accept-Version"
+
+	headerETag    = "ETag"
+	headerIfMatch = "If-Match"
 )
 
 var errNonNilContext = errors.New("context must be non-nil")
 
+// ErrPaginationStalled is returned together with the results read so far by the methods that go through every page
+// of a list when Adobe Sign returns a next page pointer that does not move forward. The results are then incomplete.
+var ErrPaginationStalled = errors.New("adobesign: pagination did not advance, results are incomplete")
+
 func Endpoint(baseUrl string) oauth2.Endpoint {
 	return oauth2.Endpoint{
 		AuthURL:  fmt.Sprintf("%s/public/oauth/%s", baseUrl, oauthApiVersion),
@@ -65,6 +72,14 @@ type Client struct {
 	TransientDocumentService *TransientDocumentService
 	AgreementService         *AgreementService
 	WebhookService           *WebhookService
+	LibraryDocumentService   *LibraryDocumentService
+	WidgetService            *WidgetService
+	MegaSignService          *MegaSignService
+	UserService              *UserService
+	GroupService             *GroupService
+	WorkflowService          *WorkflowService
+	SearchService            *SearchService
+	AccountService           *AccountService
 }
 
 type service struct {
@@ -146,6 +161,14 @@ func newClient(httpClient *http.Client, baseUrl string, impersonating string) *C
 	c.TransientDocumentService = (*TransientDocumentService)(&c.common)
 	c.AgreementService = (*AgreementService)(&c.common)
 	c.WebhookService = (*WebhookService)(&c.common)
+	c.LibraryDocumentService = (*LibraryDocumentService)(&c.common)
+	c.WidgetService = (*WidgetService)(&c.common)
+	c.MegaSignService = (*MegaSignService)(&c.common)
+	c.UserService = (*UserService)(&c.common)
+	c.GroupService = (*GroupService)(&c.common)
+	c.WorkflowService = (*WorkflowService)(&c.common)
+	c.SearchService = (*SearchService)(&c.common)
+	c.AccountService = (*AccountService)(&c.common)
 
 	return c
 }
@@ -238,7 +261,7 @@ type Response struct {
 	////
 	//// These fields support what is called "offset pagination" and should
 	//// be used with the ListOptions struct.
-	NextCursor int
+	NextCursor string
 
 	// Explicitly specify the Rate type so Rate's String() receiver doesn't
 	// propagate to Response.
@@ -424,6 +447,20 @@ func (c *Client) Do(ctx context.Context, req *http.Request, v interface{}) (*Res
 	return resp, err
 }
 
+// DoStream sends an API request and returns the body of the API response without reading it, so large files can be
+// streamed to their destination. The caller must close the returned body. On error the body is closed and nil is
+// returned.
+func (c *Client) DoStream(ctx context.Context, req *http.Request) (io.ReadCloser, error) {
+	resp, err := c.BareDo(ctx, req)
+	if err != nil {
+		if resp != nil && resp.Response != nil && resp.Body != nil {
+			resp.Body.Close()
+		}
+		return nil, err
+	}
+	return resp.Body, nil
+}
+
 // An ErrorResponse reports one or more errors caused by an API request.
 type ErrorResponse struct {
 	Response *http.Response // HTTP response that caused this error
@@ -466,10 +503,11 @@ func CheckResponse(r *http.Response) error {
 // ListOptions specifies the optional parameters to various List methods that
 // support offset pagination.
 type ListOptions struct {
-	// Maximum number of Items to be returned (max limit: 100)
-	Cursor int `url:"cursor,omitempty"`
+	// Cursor used for pagination, taken from the PageInfo of the previous page.
+	// Adobe Sign cursors are opaque strings.
+	Cursor string `url:"cursor,omitempty"`
 
-	// 	Offset used for pagination if collection has more than limit items
+	// Maximum number of Items to be returned (max limit: 100)
 	PageSize int `url:"pageSize,omitempty"`
 }
 
@@ -497,5 +535,11 @@ func addOptions(s string, opts interface{}) (string, error) {
 
 //PageInfo holds the pagination information for a Adobe Sign API request
 type PageInfo struct {
-	NextCursor int `json:"nextCursor,omitempty"`
+	NextCursor string `json:"nextCursor,omitempty"`
 }
+
+// Bool returns a pointer to v, to set optional boolean fields such as the values of GroupSettings.
+func Bool(v bool) *bool { return &v }
+
+// Float64 returns a pointer to v, to set optional numeric fields such as the bounds of a FormField.
+func Float64(v float64) *float64 { return &v }
@@ -0,0 +1,19 @@
+package adobesign
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// setup starts a test HTTP server and returns a client pointed at it together with the mux requests are routed
+// through. The server is closed when the test ends.
+func setup(t *testing.T) (*Client, *http.ServeMux) {
+	t.Helper()
+
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	return newClient(server.Client(), server.URL+"/", ""), mux
+}
@@ -3,7 +3,11 @@ package adobesign
 import (
 	"bytes"
 	"context"
+	"errors"
 	"fmt"
+	"io"
+	"strings"
+	"time"
 )
 
 const agreementsPath = "agreements"
@@ -328,7 +332,7 @@ type SenderInfo struct {
 	// Company of the sender, if available.
 	Company string `json:"company"`
 	// Email of the sender of the agreement.
-	Email string `json:"email`
+	Email string `json:"email"`
 	// Hidden True if the agreement is hidden for the user that is calling the API. Only returned if self is true.
 	Hidden bool `json:"hidden"`
 	// Name of the sender, if available.
@@ -402,20 +406,126 @@ func (s *AgreementService) CreateAgreement(ctx context.Context, request Agreemen
 // GetAgreement retrieves an existing Adobe Sign Agreement
 // ref: https://secure.na1.echosign.com/public/docs/restapi/v6#!/agreements/getAgreementInfo
 func (s *AgreementService) GetAgreement(ctx context.Context, agreementId string) (*Agreement, error) {
+	response, _, err := s.GetAgreementWithETag(ctx, agreementId)
+	return response, err
+}
+
+// GetAgreementWithETag retrieves an existing Adobe Sign Agreement together with its ETag, which must be provided
+// when updating the agreement
+// ref: https://secure.na1.echosign.com/public/docs/restapi/v6#!/agreements/getAgreementInfo
+func (s *AgreementService) GetAgreementWithETag(ctx context.Context, agreementId string) (*Agreement, string, error) {
 
 	u := fmt.Sprintf("%s/%s", agreementsPath, agreementId)
 
 	req, err := s.client.NewRequest("GET", u, nil)
 	if err != nil {
-		return nil, err
+		return nil, "", err
 	}
 
 	var response *Agreement
-	if _, err := s.client.Do(ctx, req, &response); err != nil {
-		return nil, err
+	resp, err := s.client.Do(ctx, req, &response)
+	if err != nil {
+		return nil, "", err
 	}
 
-	return response, nil
+	return response, resp.Header.Get(headerETag), nil
+}
+
+// UpdateAgreement updates the name, message, participants, files and other properties of an agreement in the DRAFT
+// or AUTHORING state. The etag returned by GetAgreementWithETag is sent in the If-Match header so the update fails if
+// the agreement was modified in between.
+// ref: https://secure.na1.echosign.com/public/docs/restapi/v6#!/agreements/updateAgreement
+func (s *AgreementService) UpdateAgreement(ctx context.Context, agreementId, etag string, request Agreement) error {
+
+	u := fmt.Sprintf("%s/%s", agreementsPath, agreementId)
+
+	req, err := s.client.NewRequest("PUT", u, request)
+	if err != nil {
+		return err
+	}
+	if etag != "" {
+		req.Header.Set(headerIfMatch, etag)
+	}
+
+	_, err = s.client.Do(ctx, req, nil)
+	return err
+}
+
+// EditAndSendAgreement fetches an agreement in the DRAFT or AUTHORING state, applies edit to it, puts it back with
+// ETag protection and sends it to the participants. Only the writable fields of the agreement are put back. Drafts
+// are sent by the ETag protected update itself, setting their state to IN_PROCESS, while agreements in AUTHORING are
+// moved to IN_PROCESS through the state endpoint once updated. If edit returns an error the agreement is left
+// untouched.
+func (s *AgreementService) EditAndSendAgreement(ctx context.Context, agreementId string, edit func(agreement *Agreement) error) error {
+	agreement, etag, err := s.GetAgreementWithETag(ctx, agreementId)
+	if err != nil {
+		return err
+	}
+
+	if agreement.Status != AgreementState.Draft && agreement.Status != AgreementState.Authoring {
+		return fmt.Errorf("agreement %s cannot be edited in status %s", agreementId, agreement.Status)
+	}
+
+	if err := edit(agreement); err != nil {
+		return err
+	}
+
+	authoring := agreement.Status == AgreementState.Authoring
+	if !authoring {
+		agreement.State = AgreementState.InProcess
+	}
+
+	if err := s.UpdateAgreement(ctx, agreementId, etag, writableAgreement(agreement)); err != nil {
+		return err
+	}
+
+	if !authoring {
+		return nil
+	}
+	return s.UpdateAgreementState(ctx, agreementId, UpdateAgreementRequest{State: AgreementState.InProcess})
+}
+
+// writableAgreement returns the fields of agreement that can be set in an update. The read-only fields returned by
+// GetAgreement, such as the id, status and dates of the agreement and the ids of its participants, are left out.
+func writableAgreement(agreement *Agreement) Agreement {
+	participantSets := make([]ParticipantSetInfo, len(agreement.ParticipantSetsInfo))
+	for i, set := range agreement.ParticipantSetsInfo {
+		members := make([]MemberInfo, len(set.MemberInfos))
+		for j, member := range set.MemberInfos {
+			member.Id = ""
+			members[j] = member
+		}
+		set.Id = ""
+		set.MemberInfos = members
+		participantSets[i] = set
+	}
+
+	return Agreement{
+		FileInfos:                 agreement.FileInfos,
+		Name:                      agreement.Name,
+		ParticipantSetsInfo:       participantSets,
+		SignatureType:             agreement.SignatureType,
+		State:                     agreement.State,
+		Ccs:                       agreement.Ccs,
+		DeviceInfo:                agreement.DeviceInfo,
+		DocumentVisibilityEnabled: agreement.DocumentVisibilityEnabled,
+		EmailOption:               agreement.EmailOption,
+		ExpirationTime:            agreement.ExpirationTime,
+		ExternalId:                agreement.ExternalId,
+		FirstReminderDelay:        agreement.FirstReminderDelay,
+		FormFieldLayerTemplates:   agreement.FormFieldLayerTemplates,
+		GroupId:                   agreement.GroupId,
+		Locale:                    agreement.Locale,
+		MergeFieldInfo:            agreement.MergeFieldInfo,
+		Message:                   agreement.Message,
+		NotaryInfo:                agreement.NotaryInfo,
+		PostSignOption:            agreement.PostSignOption,
+		ReminderFrequency:         agreement.ReminderFrequency,
+		SecurityOption:            agreement.SecurityOption,
+		Type:                      agreement.Type,
+		VaultingInfo:              agreement.VaultingInfo,
+		WorkflowId:                agreement.WorkflowId,
+	}
 }
 
 // GetAuditTrail retrieves the PDF file stream containing audit trail information
@@ -513,3 +623,939 @@ func (s *AgreementService) GetAgreementMembers(ctx context.Context, agreementId
 
 	return response, nil
 }
+
+type DeviceLocation struct {
+	// Latitude coordinate
+	Latitude float64 `json:"latitude,omitempty"`
+	// Longitude coordinate
+	Longitude float64 `json:"longitude,omitempty"`
+}
+
+type DigitalSignatureInfo struct {
+	// Company of the digital signer
+	Company string `json:"company,omitempty"`
+	// Email of the digital signer
+	Email string `json:"email,omitempty"`
+	// Name of the digital signer
+	Name string `json:"name,omitempty"`
+}
+
+// AgreementEvent describes a single entry in the history of an agreement
+// ref: https://secure.na1.adobesign.com/public/docs/restapi/v6#!/agreements/getEvents
+type AgreementEvent struct {
+	// ActingUserEmail Email address of the user that initiated the event
+	ActingUserEmail string `json:"actingUserEmail,omitempty"`
+	// ActingUserIpAddress The IP address of the user that initiated the event
+	ActingUserIpAddress string `json:"actingUserIpAddress,omitempty"`
+	// ActingUserName Name of the user that initiated the event
+	ActingUserName string `json:"actingUserName,omitempty"`
+	// Comment Comment provided by the participant, e.g. the reason of a rejection or a delegation
+	Comment string `json:"comment,omitempty"`
+	// Date The date of the audit event. Format would be yyyy-MM-dd'T'HH:mm:ssZ
+	Date string `json:"date,omitempty"`
+	// Description A description of the audit event
+	Description string `json:"description,omitempty"`
+	// DeviceLocation Location of the device that generated the event (This value may be null due to limited
+	//privileges)
+	DeviceLocation DeviceLocation `json:"deviceLocation,omitempty"`
+	// DevicePhoneNumber Phone number from the device used when the participation is completed on a mobile phone
+	DevicePhoneNumber string `json:"devicePhoneNumber,omitempty"`
+	// DigitalSignatureInfo Digital signature info, only present for digitally signed events
+	DigitalSignatureInfo DigitalSignatureInfo `json:"digitalSignatureInfo,omitempty"`
+	// Id The unique identifier of the event
+	Id string `json:"id,omitempty"`
+	// InitiatingUserEmail Email address of the user that initiated the event on behalf of the acting user
+	InitiatingUserEmail string `json:"initiatingUserEmail,omitempty"`
+	// InitiatingUserName Full name of the user that initiated the event on behalf of the acting user
+	InitiatingUserName string `json:"initiatingUserName,omitempty"`
+	// ParticipantEmail Email address of the participant affected by the event
+	ParticipantEmail string `json:"participantEmail,omitempty"`
+	// ParticipantId The unique identifier of the participant affected by the event
+	ParticipantId string `json:"participantId,omitempty"`
+	// ParticipantRole Role of the participant affected by the event, see ParticipantRole
+	ParticipantRole string `json:"participantRole,omitempty"`
+	// ReplacedParticipantEmail Email address of the participant that was replaced, only for REPLACED_SIGNER events
+	ReplacedParticipantEmail string `json:"replacedParticipantEmail,omitempty"`
+	// SynchronizationId A unique identifier linking offline events to synchronization events
+	SynchronizationId string `json:"synchronizationId,omitempty"`
+	// Type of the event, see AgreementEventType
+	Type string `json:"type,omitempty"`
+	// VaultEventId The identifier assigned by the vault provider for the vault event
+	VaultEventId string `json:"vaultEventId,omitempty"`
+	// VaultProviderName Name of the vault provider for the vault event
+	VaultProviderName string `json:"vaultProviderName,omitempty"`
+	// VersionId An ID which uniquely identifies the version of the document associated with this audit event
+	VersionId string `json:"versionId,omitempty"`
+}
+
+type AgreementEvents struct {
+	// Events An ordered list of the events in the audit trail of this agreement
+	Events []AgreementEvent `json:"events"`
+}
+
+// agreementEventWebhooks maps the agreement event types to the webhook subscription events sent for them.
+var agreementEventWebhooks = map[string]string{
+	AgreementEventType.Created:                        WebhookSubscriptionEvent.AgreementCreated,
+	AgreementEventType.Expired:                        WebhookSubscriptionEvent.AgreementExpired,
+	AgreementEventType.ExpiredAutomatically:           WebhookSubscriptionEvent.AgreementExpired,
+	AgreementEventType.DocumentsDeleted:               WebhookSubscriptionEvent.AgreementDocumentsDeleted,
+	AgreementEventType.Recalled:                       WebhookSubscriptionEvent.AgreementRecalled,
+	AgreementEventType.Rejected:                       WebhookSubscriptionEvent.AgreementRejected,
+	AgreementEventType.Shared:                         WebhookSubscriptionEvent.AgreementShared,
+	AgreementEventType.ActionDelegated:                WebhookSubscriptionEvent.AgreementActionDelegated,
+	AgreementEventType.AutoDelegated:                  WebhookSubscriptionEvent.AgreementActionDelegated,
+	AgreementEventType.ActionReplacedSigner:           WebhookSubscriptionEvent.AgreementActionReplacedSigner,
+	AgreementEventType.Modified:                       WebhookSubscriptionEvent.AgreementModified,
+	AgreementEventType.UserAckAgreementModified:       WebhookSubscriptionEvent.AgreementUserAckAgreementModified,
+	AgreementEventType.EmailViewed:                    WebhookSubscriptionEvent.AgreementEmailViewed,
+	AgreementEventType.EmailBounced:                   WebhookSubscriptionEvent.AgreementEmailBounced,
+	AgreementEventType.AutoCancelledConversionProblem: WebhookSubscriptionEvent.AgreementAutoCancelledConversionProblem,
+	AgreementEventType.OfflineSync:                    WebhookSubscriptionEvent.AgreementOfflineSync,
+	AgreementEventType.UploadedBySender:               WebhookSubscriptionEvent.AgreementUploadedBySender,
+	AgreementEventType.Vaulted:                        WebhookSubscriptionEvent.AgreementVaulted,
+	AgreementEventType.WebIdentityAuthenticated:       WebhookSubscriptionEvent.AgreementWebIdentityAuthenticated,
+	AgreementEventType.KbaAuthenticated:               WebhookSubscriptionEvent.AgreementKbaAuthenticated,
+	AgreementEventType.SignatureRequested:             WebhookSubscriptionEvent.AgreementActionRequested,
+	AgreementEventType.ApprovalRequested:              WebhookSubscriptionEvent.AgreementActionRequested,
+	AgreementEventType.AcceptanceRequested:            WebhookSubscriptionEvent.AgreementActionRequested,
+	AgreementEventType.FormFillingRequested:           WebhookSubscriptionEvent.AgreementActionRequested,
+	AgreementEventType.DeliveryRequested:              WebhookSubscriptionEvent.AgreementActionRequested,
+	AgreementEventType.Signed:                         WebhookSubscriptionEvent.AgreementActionCompleted,
+	AgreementEventType.ESigned:                        WebhookSubscriptionEvent.AgreementActionCompleted,
+	AgreementEventType.DigSigned:                      WebhookSubscriptionEvent.AgreementActionCompleted,
+	AgreementEventType.Approved:                       WebhookSubscriptionEvent.AgreementActionCompleted,
+	AgreementEventType.Accepted:                       WebhookSubscriptionEvent.AgreementActionCompleted,
+	AgreementEventType.FormFilled:                     WebhookSubscriptionEvent.AgreementActionCompleted,
+	AgreementEventType.Delivered:                      WebhookSubscriptionEvent.AgreementActionCompleted,
+}
+
+// WebhookSubscriptionEvent returns the webhook subscription event that Adobe Sign sends for this event,
+// or an empty string if the event has no webhook counterpart.
+func (e AgreementEvent) WebhookSubscriptionEvent() string {
+	return agreementEventWebhooks[e.Type]
+}
+
+// GetAgreementEvents retrieves the history of events of an existing AdobeSign Agreement
+// ref: https://secure.na1.adobesign.com/public/docs/restapi/v6#!/agreements/getEvents
+func (s *AgreementService) GetAgreementEvents(ctx context.Context, agreementId string) ([]AgreementEvent, error) {
+	u := fmt.Sprintf("%s/%s/events", agreementsPath, agreementId)
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var response AgreementEvents
+	if _, err := s.client.Do(ctx, req, &response); err != nil {
+		return nil, err
+	}
+
+	return response.Events, nil
+}
+
+// GetFormFields retrieves the form fields of an existing AdobeSign Agreement
+// ref: https://secure.na1.adobesign.com/public/docs/restapi/v6#!/agreements/getFormFields
+func (s *AgreementService) GetFormFields(ctx context.Context, agreementId string) ([]FormField, error) {
+	u := fmt.Sprintf("%s/%s/formFields", agreementsPath, agreementId)
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var response FormFieldList
+	if _, err := s.client.Do(ctx, req, &response); err != nil {
+		return nil, err
+	}
+
+	return response.Fields, nil
+}
+
+// UpdateFormFields replaces the form fields of an agreement in the AUTHORING state
+// ref: https://secure.na1.adobesign.com/public/docs/restapi/v6#!/agreements/updateFormFields
+func (s *AgreementService) UpdateFormFields(ctx context.Context, agreementId string, fields []FormField) ([]FormField, error) {
+	u := fmt.Sprintf("%s/%s/formFields", agreementsPath, agreementId)
+
+	req, err := s.client.NewRequest("PUT", u, FormFieldList{Fields: fields})
+	if err != nil {
+		return nil, err
+	}
+
+	var response FormFieldList
+	if _, err := s.client.Do(ctx, req, &response); err != nil {
+		return nil, err
+	}
+
+	return response.Fields, nil
+}
+
+// GetFormData retrieves the data entered by the participants into the form fields of an existing AdobeSign
+// Agreement, one row per participant
+// ref: https://secure.na1.adobesign.com/public/docs/restapi/v6#!/agreements/getFormData
+func (s *AgreementService) GetFormData(ctx context.Context, agreementId string) ([]FormDataRow, error) {
+	u := fmt.Sprintf("%s/%s/formData", agreementsPath, agreementId)
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var response bytes.Buffer
+	if _, err := s.client.Do(ctx, req, &response); err != nil {
+		return nil, err
+	}
+
+	return parseFormData(&response)
+}
+
+// errorCodeAgreementNotSignable is returned by the signing urls endpoint until the agreement documents are processed.
+const errorCodeAgreementNotSignable = "AGREEMENT_NOT_SIGNABLE"
+
+// Bounds of the backoff used by WaitForSigningUrls.
+const (
+	signingUrlsInitialBackoff = time.Second
+	signingUrlsMaxBackoff     = 30 * time.Second
+)
+
+type SigningUrl struct {
+	// Email The email address of the signer associated with this signing url
+	Email string `json:"email"`
+	// EsignUrl The url used by the signer to sign the agreement
+	EsignUrl string `json:"esignUrl"`
+}
+
+type SigningUrlSetInfo struct {
+	// SigningUrls An array of urls for signer sets involved in this agreement
+	SigningUrls []SigningUrl `json:"signingUrls"`
+	// SigningUrlSetName The name of the current signer set. Returned only if the API caller is the sender of the
+	//agreement
+	SigningUrlSetName string `json:"signingUrlSetName,omitempty"`
+}
+
+type SigningUrlResponse struct {
+	// SigningUrlSetInfos An array of urls for current signer sets involved in this agreement
+	SigningUrlSetInfos []SigningUrlSetInfo `json:"signingUrlSetInfos"`
+}
+
+// GetSigningUrls retrieves the urls for the current signers of an existing AdobeSign Agreement. Until the agreement
+// documents are processed Adobe Sign responds with an AGREEMENT_NOT_SIGNABLE error, see WaitForSigningUrls
+// ref: https://secure.na1.adobesign.com/public/docs/restapi/v6#!/agreements/getSigningUrls
+func (s *AgreementService) GetSigningUrls(ctx context.Context, agreementId string) (*SigningUrlResponse, error) {
+	u := fmt.Sprintf("%s/%s/signingUrls", agreementsPath, agreementId)
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var response *SigningUrlResponse
+	if _, err := s.client.Do(ctx, req, &response); err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+// WaitForSigningUrls polls GetSigningUrls with an exponential backoff until the signing urls are available.
+// It returns when the urls are available, when Adobe Sign returns an error other than AGREEMENT_NOT_SIGNABLE or
+// when ctx is done, so callers should always provide a context with a deadline.
+func (s *AgreementService) WaitForSigningUrls(ctx context.Context, agreementId string) (*SigningUrlResponse, error) {
+	backoff := signingUrlsInitialBackoff
+	for {
+		response, err := s.GetSigningUrls(ctx, agreementId)
+		if err == nil {
+			return response, nil
+		}
+
+		var errorResponse *ErrorResponse
+		if !errors.As(err, &errorResponse) || errorResponse.Code != errorCodeAgreementNotSignable {
+			return nil, err
+		}
+
+		timer := time.NewTimer(backoff)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, ctx.Err()
+		case <-timer.C:
+		}
+
+		backoff *= 2
+		if backoff > signingUrlsMaxBackoff {
+			backoff = signingUrlsMaxBackoff
+		}
+	}
+}
+
+type CommonViewConfiguration struct {
+	// AutoLoginUser Auto LogIn Flag. If true, the URL returned will automatically log the user in. If false, the
+	//URL returned will require the credentials. By default its value is false
+	AutoLoginUser bool `json:"autoLoginUser,omitempty"`
+	// Locale Locale in which the view should be shown
+	Locale string `json:"locale,omitempty"`
+	// NoChrome Embedded Flag. If true, the URL returned will not contain the header and footer, which makes it
+	//suitable for embedding in an iframe
+	NoChrome bool `json:"noChrome,omitempty"`
+}
+
+type AgreementViewInfo struct {
+	// Name Name of the requested agreement view, see AgreementView
+	Name string `json:"name"`
+	// CommonViewConfiguration Configuration that applies to all the requested views
+	CommonViewConfiguration CommonViewConfiguration `json:"commonViewConfiguration,omitempty"`
+}
+
+type ViewUrl struct {
+	// Name of the view, see AgreementView
+	Name string `json:"name"`
+	// Url of the view
+	Url string `json:"url"`
+	// EmbeddedCode Embedded code of the view, suitable for an iframe
+	EmbeddedCode string `json:"embeddedCode,omitempty"`
+	// Height of the embedded view in pixels
+	Height int `json:"height,omitempty"`
+	// Width of the embedded view in pixels
+	Width int `json:"width,omitempty"`
+	// IsCurrent True if this is the view that best represents the current state of the resource
+	IsCurrent bool `json:"isCurrent,omitempty"`
+}
+
+type AgreementViews struct {
+	// AgreementViewList List of the requested agreement views
+	AgreementViewList []ViewUrl `json:"agreementViewList"`
+}
+
+// CreateAgreementView returns the urls of the requested pages of an existing AdobeSign Agreement
+// ref: https://secure.na1.adobesign.com/public/docs/restapi/v6#!/agreements/createAgreementView
+func (s *AgreementService) CreateAgreementView(ctx context.Context, agreementId string, request AgreementViewInfo) ([]ViewUrl, error) {
+	u := fmt.Sprintf("%s/%s/views", agreementsPath, agreementId)
+
+	req, err := s.client.NewRequest("POST", u, request)
+	if err != nil {
+		return nil, err
+	}
+
+	var response AgreementViews
+	if _, err := s.client.Do(ctx, req, &response); err != nil {
+		return nil, err
+	}
+
+	return response.AgreementViewList, nil
+}
+
+// GetParticipantSet retrieves a participant set of an existing AdobeSign Agreement together with its ETag, which
+// must be provided when updating the participant set
+// ref: https://secure.na1.adobesign.com/public/docs/restapi/v6#!/agreements/getParticipantSet
+func (s *AgreementService) GetParticipantSet(ctx context.Context, agreementId, participantSetId string) (*DetailedParticipantSetInfo, string, error) {
+	u := fmt.Sprintf("%s/%s/members/participantSets/%s", agreementsPath, agreementId, participantSetId)
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, "", err
+	}
+
+	var response *DetailedParticipantSetInfo
+	resp, err := s.client.Do(ctx, req, &response)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return response, resp.Header.Get(headerETag), nil
+}
+
+// UpdateParticipantSet replaces the members of a participant set of an existing AdobeSign Agreement. Members that are
+// not part of request.MemberInfos are replaced, new members are added. The etag returned by GetParticipantSet is sent
+// in the If-Match header so the update fails if the participant set was modified in between.
+// ref: https://secure.na1.adobesign.com/public/docs/restapi/v6#!/agreements/updateParticipantSet
+func (s *AgreementService) UpdateParticipantSet(ctx context.Context, agreementId, participantSetId, etag string, request DetailedParticipantSetInfo) (*DetailedParticipantSetInfo, error) {
+	u := fmt.Sprintf("%s/%s/members/participantSets/%s", agreementsPath, agreementId, participantSetId)
+
+	req, err := s.client.NewRequest("PUT", u, request)
+	if err != nil {
+		return nil, err
+	}
+	if etag != "" {
+		req.Header.Set(headerIfMatch, etag)
+	}
+
+	if _, err := s.client.Do(ctx, req, nil); err != nil {
+		return nil, err
+	}
+
+	response, _, err := s.GetParticipantSet(ctx, agreementId, participantSetId)
+	return response, err
+}
+
+type DelegatedParticipantSetInfo struct {
+	// MemberInfos Array of ParticipantInfo objects, containing participant-specific data (e.g. email). All
+	//participants in the array belong to the same set
+	MemberInfos []DetailedParticipantInfo `json:"memberInfos"`
+	// Name of the delegated participant set
+	Name string `json:"name,omitempty"`
+	// PrivateMessage Participant set's private message - all participants in the set will receive the same message
+	PrivateMessage string `json:"privateMessage,omitempty"`
+}
+
+type DelegatedParticipantSetResult struct {
+	Id string `json:"id"`
+}
+
+// DelegateParticipantSet delegates the action of a participant set of an existing AdobeSign Agreement to other
+// participants
+// ref: https://secure.na1.adobesign.com/public/docs/restapi/v6#!/agreements/createDelegatedParticipantSets
+func (s *AgreementService) DelegateParticipantSet(ctx context.Context, agreementId, participantSetId string, request DelegatedParticipantSetInfo) (*DelegatedParticipantSetResult, error) {
+	u := fmt.Sprintf("%s/%s/members/participantSets/%s/delegatedParticipantSets", agreementsPath, agreementId, participantSetId)
+
+	req, err := s.client.NewRequest("POST", u, request)
+	if err != nil {
+		return nil, err
+	}
+
+	var response *DelegatedParticipantSetResult
+	if _, err := s.client.Do(ctx, req, &response); err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+// participantStatusReplaced is the status of members that were replaced in a participant set.
+const participantStatusReplaced = "REPLACED"
+
+// ReplaceParticipantEmail replaces the participant with email oldEmail by newEmail in every participant set of an
+// existing AdobeSign Agreement. The security options of the replaced participant are kept for the new one. It returns
+// the participant sets that were updated.
+func (s *AgreementService) ReplaceParticipantEmail(ctx context.Context, agreementId, oldEmail, newEmail string) ([]DetailedParticipantSetInfo, error) {
+	members, err := s.GetAgreementMembers(ctx, agreementId)
+	if err != nil {
+		return nil, err
+	}
+
+	var updated []DetailedParticipantSetInfo
+	for _, set := range members.ParticipantSets {
+		if !hasActiveParticipant(set, oldEmail) {
+			continue
+		}
+
+		// Fetch the set again to get its ETag.
+		current, etag, err := s.GetParticipantSet(ctx, agreementId, set.Id)
+		if err != nil {
+			return updated, err
+		}
+
+		request := *current
+		request.MemberInfos = nil
+		for _, member := range current.MemberInfos {
+			if member.Status == participantStatusReplaced {
+				continue
+			}
+			if strings.EqualFold(member.Email, oldEmail) {
+				member = DetailedParticipantInfo{Email: newEmail, SecurityOption: member.SecurityOption}
+			}
+			request.MemberInfos = append(request.MemberInfos, member)
+		}
+
+		result, err := s.UpdateParticipantSet(ctx, agreementId, set.Id, etag, request)
+		if err != nil {
+			return updated, err
+		}
+		updated = append(updated, *result)
+	}
+
+	return updated, nil
+}
+
+// hasActiveParticipant reports whether set has a member with the given email that was not replaced.
+func hasActiveParticipant(set DetailedParticipantSetInfo, email string) bool {
+	for _, member := range set.MemberInfos {
+		if member.Status != participantStatusReplaced && strings.EqualFold(member.Email, email) {
+			return true
+		}
+	}
+	return false
+}
+
+type ShareCreationInfo struct {
+	// Email of the user with whom the agreement will be shared
+	Email string `json:"email"`
+	// Message An optional message to the user with whom the agreement is shared
+	Message string `json:"message,omitempty"`
+}
+
+type ShareCreationInfoList struct {
+	ShareCreationInfo []ShareCreationInfo `json:"shareCreationInfo"`
+}
+
+type ShareCreationResponse struct {
+	// Email of the user with whom the agreement was shared
+	Email string `json:"email"`
+	// Id The unique identifier of the share participant
+	Id string `json:"id"`
+}
+
+type ShareCreationResponseList struct {
+	ShareCreationResponseList []ShareCreationResponse `json:"shareCreationResponseList"`
+}
+
+// ShareAgreement shares an existing AdobeSign Agreement with other users
+// ref: https://secure.na1.adobesign.com/public/docs/restapi/v6#!/agreements/createShareOnAgreement
+func (s *AgreementService) ShareAgreement(ctx context.Context, agreementId string, shares []ShareCreationInfo) ([]ShareCreationResponse, error) {
+	u := fmt.Sprintf("%s/%s/members/share", agreementsPath, agreementId)
+
+	req, err := s.client.NewRequest("POST", u, ShareCreationInfoList{ShareCreationInfo: shares})
+	if err != nil {
+		return nil, err
+	}
+
+	var response ShareCreationResponseList
+	if _, err := s.client.Do(ctx, req, &response); err != nil {
+		return nil, err
+	}
+
+	return response.ShareCreationResponseList, nil
+}
+
+type Note struct {
+	// Note The content of the note
+	Note string `json:"note"`
+}
+
+// GetNote retrieves the personal note of the calling user on an existing AdobeSign Agreement
+// ref: https://secure.na1.adobesign.com/public/docs/restapi/v6#!/agreements/getAgreementNoteForApiUser
+func (s *AgreementService) GetNote(ctx context.Context, agreementId string) (string, error) {
+	u := fmt.Sprintf("%s/%s/me/note", agreementsPath, agreementId)
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return "", err
+	}
+
+	var response Note
+	if _, err := s.client.Do(ctx, req, &response); err != nil {
+		return "", err
+	}
+
+	return response.Note, nil
+}
+
+// UpdateNote replaces the personal note of the calling user on an existing AdobeSign Agreement
+// ref: https://secure.na1.adobesign.com/public/docs/restapi/v6#!/agreements/updateAgreementNoteForApiUser
+func (s *AgreementService) UpdateNote(ctx context.Context, agreementId string, note string) error {
+	u := fmt.Sprintf("%s/%s/me/note", agreementsPath, agreementId)
+
+	req, err := s.client.NewRequest("PUT", u, Note{Note: note})
+	if err != nil {
+		return err
+	}
+
+	_, err = s.client.Do(ctx, req, nil)
+	return err
+}
+
+type VisibilityInfo struct {
+	// Visibility ['HIDE' or 'SHOW']: Whether the resource is hidden from or shown to the calling user, see Visibility
+	Visibility string `json:"visibility"`
+}
+
+// UpdateVisibility hides or shows an existing AdobeSign Agreement in the agreement list of the calling user
+// ref: https://secure.na1.adobesign.com/public/docs/restapi/v6#!/agreements/updateAgreementVisibility
+func (s *AgreementService) UpdateVisibility(ctx context.Context, agreementId string, visibility string) error {
+	u := fmt.Sprintf("%s/%s/me/visibility", agreementsPath, agreementId)
+
+	req, err := s.client.NewRequest("PUT", u, VisibilityInfo{Visibility: visibility})
+	if err != nil {
+		return err
+	}
+
+	_, err = s.client.Do(ctx, req, nil)
+	return err
+}
+
+type ReminderInfoList struct {
+	// ReminderInfoList The reminders of the agreement
+	ReminderInfoList []ReminderInfo `json:"reminderInfoList"`
+}
+
+// GetReminders retrieves the reminders of an existing AdobeSign Agreement
+// ref: https://secure.na1.adobesign.com/public/docs/restapi/v6#!/agreements/getAllReminders
+func (s *AgreementService) GetReminders(ctx context.Context, agreementId string) ([]ReminderInfo, error) {
+	u := fmt.Sprintf("%s/%s/reminders", agreementsPath, agreementId)
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var response ReminderInfoList
+	if _, err := s.client.Do(ctx, req, &response); err != nil {
+		return nil, err
+	}
+
+	return response.ReminderInfoList, nil
+}
+
+// UpdateReminder updates an existing reminder of an AdobeSign Agreement. Only the status (from ACTIVE to CANCELED)
+// and the note can be updated, to reschedule a reminder cancel it and create a new one
+// ref: https://secure.na1.adobesign.com/public/docs/restapi/v6#!/agreements/updateReminder
+func (s *AgreementService) UpdateReminder(ctx context.Context, agreementId, reminderId string, request ReminderInfo) error {
+	u := fmt.Sprintf("%s/%s/reminders/%s", agreementsPath, agreementId, reminderId)
+
+	req, err := s.client.NewRequest("PUT", u, request)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.client.Do(ctx, req, nil)
+	return err
+}
+
+// CancelReminder cancels an active reminder of an existing AdobeSign Agreement
+func (s *AgreementService) CancelReminder(ctx context.Context, agreementId string, reminder ReminderInfo) error {
+	// Only send the fields accepted in a PUT.
+	request := ReminderInfo{
+		RecipientParticipantIds: reminder.RecipientParticipantIds,
+		Status:                  ReminderStatus.Canceled,
+		Note:                    reminder.Note,
+	}
+	return s.UpdateReminder(ctx, agreementId, reminder.ReminderId, request)
+}
+
+// GetSignerIdentityReport retrieves the PDF file stream of the signer identity report of an agreement that has
+// HasSignerIdentityReport set. The caller must close the returned stream
+// ref: https://secure.na1.adobesign.com/public/docs/restapi/v6#!/agreements/getSignerIdentityReport
+func (s *AgreementService) GetSignerIdentityReport(ctx context.Context, agreementId string) (io.ReadCloser, error) {
+	u := fmt.Sprintf("%s/%s/signerIdentityReport", agreementsPath, agreementId)
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.DoStream(ctx, req)
+}
+
+type SupportingDocument struct {
+	// DisplayLabel Display name of the document
+	DisplayLabel string `json:"displayLabel"`
+	// FieldName The name of the supporting document field
+	FieldName string `json:"fieldName"`
+	// Id The unique identifier of the supporting document, use it with GetDocument to download it
+	Id string `json:"id"`
+	// MimeType of the document
+	MimeType string `json:"mimeType"`
+	// NumPages Number of pages in the document
+	NumPages int `json:"numPages"`
+	// ParticipantId The unique identifier of the participant that uploaded the document
+	ParticipantId string `json:"participantId"`
+}
+
+type AgreementDocuments struct {
+	// Documents A list of documents of the agreement
+	Documents []Document `json:"documents"`
+	// SupportingDocuments A list of supporting documents uploaded by the participants
+	SupportingDocuments []SupportingDocument `json:"supportingDocuments,omitempty"`
+}
+
+// GetDocuments retrieves the IDs of the documents and supporting documents of an existing AdobeSign Agreement
+// ref: https://secure.na1.adobesign.com/public/docs/restapi/v6#!/agreements/getAllDocuments
+func (s *AgreementService) GetDocuments(ctx context.Context, agreementId string) (*AgreementDocuments, error) {
+	u := fmt.Sprintf("%s/%s/documents", agreementsPath, agreementId)
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var response *AgreementDocuments
+	if _, err := s.client.Do(ctx, req, &response); err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+// GetSupportingDocuments retrieves the supporting documents of an existing AdobeSign Agreement grouped by the
+// participant id of the participant that uploaded them
+func (s *AgreementService) GetSupportingDocuments(ctx context.Context, agreementId string) (map[string][]SupportingDocument, error) {
+	documents, err := s.GetDocuments(ctx, agreementId)
+	if err != nil {
+		return nil, err
+	}
+
+	byParticipant := make(map[string][]SupportingDocument)
+	for _, document := range documents.SupportingDocuments {
+		byParticipant[document.ParticipantId] = append(byParticipant[document.ParticipantId], document)
+	}
+
+	return byParticipant, nil
+}
+
+// GetDocument retrieves the file stream of a document or a supporting document of an existing AdobeSign Agreement.
+// The caller must close the returned stream
+// ref: https://secure.na1.adobesign.com/public/docs/restapi/v6#!/agreements/getDocument
+func (s *AgreementService) GetDocument(ctx context.Context, agreementId, documentId string) (io.ReadCloser, error) {
+	u := fmt.Sprintf("%s/%s/documents/%s", agreementsPath, agreementId, documentId)
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.DoStream(ctx, req)
+}
+
+// Error codes returned when deleting the documents of an agreement.
+const (
+	errorCodeDocumentRetentionApplied = "DOCUMENT_RETENTION_APPLIED"
+	errorCodeInvalidAgreementState    = "INVALID_AGREEMENT_STATE"
+)
+
+// DocumentRetentionAppliedError occurs when deleting the documents of an agreement whose documents were already
+// purged by a retention policy.
+type DocumentRetentionAppliedError struct {
+	AgreementId string
+}
+
+func (e *DocumentRetentionAppliedError) Error() string {
+	return fmt.Sprintf("document retention already applied to agreement %s", e.AgreementId)
+}
+
+// AgreementNotTerminalError occurs when deleting the documents of an agreement that is still in progress.
+type AgreementNotTerminalError struct {
+	AgreementId string
+	// Status The status of the agreement, empty if the error was reported by Adobe Sign without it
+	Status string
+}
+
+func (e *AgreementNotTerminalError) Error() string {
+	if e.Status == "" {
+		return fmt.Sprintf("agreement %s is not in a terminal state", e.AgreementId)
+	}
+	return fmt.Sprintf("agreement %s is not in a terminal state: %s", e.AgreementId, e.Status)
+}
+
+// DeleteDocumentsOptions specifies the optional checks of DeleteDocuments.
+type DeleteDocumentsOptions struct {
+	// VerifyTerminalState retrieves the agreement first and makes DeleteDocuments fail with a
+	// *AgreementNotTerminalError, without deleting anything, unless the agreement is completed, cancelled, expired or
+	// archived.
+	VerifyTerminalState bool
+}
+
+// IsTerminal reports whether the agreement reached a status it cannot leave anymore.
+func (a Agreement) IsTerminal() bool {
+	switch a.Status {
+	case AgreementStatus.Signed, AgreementStatus.Approved, AgreementStatus.Delivered, AgreementStatus.Accepted,
+		AgreementStatus.FormFilled, AgreementStatus.Cancelled, AgreementStatus.Expired, AgreementStatus.Archived:
+		return true
+	}
+	return false
+}
+
+// DeleteDocuments deletes the documents of an existing AdobeSign Agreement, the agreement itself and its audit
+// trail are kept. It returns a *DocumentRetentionAppliedError if the documents were already deleted and a
+// *AgreementNotTerminalError if Adobe Sign refuses to delete the documents of an agreement in progress.
+// ref: https://secure.na1.adobesign.com/public/docs/restapi/v6#!/agreements/deleteDocuments
+func (s *AgreementService) DeleteDocuments(ctx context.Context, agreementId string, opts *DeleteDocumentsOptions) error {
+	if opts != nil && opts.VerifyTerminalState {
+		agreement, err := s.GetAgreement(ctx, agreementId)
+		if err != nil {
+			return err
+		}
+		if agreement.IsDocumentRetentionApplied {
+			return &DocumentRetentionAppliedError{AgreementId: agreementId}
+		}
+		if !agreement.IsTerminal() {
+			return &AgreementNotTerminalError{AgreementId: agreementId, Status: agreement.Status}
+		}
+	}
+
+	u := fmt.Sprintf("%s/%s/documents", agreementsPath, agreementId)
+
+	req, err := s.client.NewRequest("DELETE", u, nil)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.client.Do(ctx, req, nil)
+
+	var errorResponse *ErrorResponse
+	if errors.As(err, &errorResponse) {
+		switch errorResponse.Code {
+		case errorCodeDocumentRetentionApplied:
+			return &DocumentRetentionAppliedError{AgreementId: agreementId}
+		case errorCodeInvalidAgreementState:
+			return &AgreementNotTerminalError{AgreementId: agreementId}
+		}
+	}
+	return err
+}
+
+type PageImageUrl struct {
+	// PageNum The page number of the image (starting at 1)
+	PageNum int `json:"pageNum"`
+	// Url of the image of the page
+	Url string `json:"url"`
+}
+
+type ImageUrl struct {
+	// ImageSize The size of the images, see ImageSize
+	ImageSize string `json:"imageSize"`
+	// ImagesAvailable True if the images are available. Images may not be available yet while the document is
+	//being processed
+	ImagesAvailable bool `json:"imagesAvailable"`
+	// Urls The urls of the images of the pages
+	Urls []PageImageUrl `json:"urls,omitempty"`
+}
+
+// Pages returns the image urls keyed by page number.
+func (i ImageUrl) Pages() map[int]string {
+	pages := make(map[int]string, len(i.Urls))
+	for _, url := range i.Urls {
+		pages[url.PageNum] = url.Url
+	}
+	return pages
+}
+
+type DocumentImageUrls struct {
+	// Id The unique identifier of the document
+	Id string `json:"id,omitempty"`
+	// ImageUrls The image urls of the pages of the document, one entry per requested image size
+	ImageUrls []ImageUrl `json:"imageUrls"`
+}
+
+type AgreementDocumentImageUrls struct {
+	// DocumentImageUrlsList The image urls of each document of the agreement
+	DocumentImageUrlsList []DocumentImageUrls `json:"documentImageUrlsList"`
+	// SupportingDocumentImageUrls The image urls of each supporting document of the agreement
+	SupportingDocumentImageUrls []DocumentImageUrls `json:"supportingDocumentImageUrls,omitempty"`
+}
+
+// ImageUrlsOptions specifies the optional parameters of GetDocumentsImageUrls.
+type ImageUrlsOptions struct {
+	// VersionId The version of the documents, defaults to the latest version
+	VersionId string `url:"versionId,omitempty"`
+	// ParticipantId Returns the images as seen by this participant
+	ParticipantId string `url:"participantId,omitempty"`
+	// ImageSizes The sizes of the images to return, see ImageSize
+	ImageSizes []string `url:"imageSizes,comma,omitempty"`
+	// IncludeSupportingDocumentsImageUrls Also returns the image urls of the supporting documents
+	IncludeSupportingDocumentsImageUrls bool `url:"includeSupportingDocumentsImageUrls,omitempty"`
+	// ShowImageAvailabilityOnly Only returns whether the images are available, without the urls
+	ShowImageAvailabilityOnly bool `url:"showImageAvailabilityOnly,omitempty"`
+}
+
+// DocumentImageUrlsOptions specifies the optional parameters of GetDocumentImageUrls.
+type DocumentImageUrlsOptions struct {
+	// VersionId The version of the document, defaults to the latest version
+	VersionId string `url:"versionId,omitempty"`
+	// ParticipantId Returns the images as seen by this participant
+	ParticipantId string `url:"participantId,omitempty"`
+	// ImageSizes The sizes of the images to return, see ImageSize
+	ImageSizes []string `url:"imageSizes,comma,omitempty"`
+	// ShowImageAvailabilityOnly Only returns whether the images are available, without the urls
+	ShowImageAvailabilityOnly bool `url:"showImageAvailabilityOnly,omitempty"`
+	// StartPage The first page to return (starting at 1)
+	StartPage int `url:"startPage,omitempty"`
+	// EndPage The last page to return
+	EndPage int `url:"endPage,omitempty"`
+}
+
+// GetDocumentsImageUrls retrieves the urls of the page images of all the documents of an existing AdobeSign Agreement
+// ref: https://secure.na1.adobesign.com/public/docs/restapi/v6#!/agreements/getDocumentsImageUrls
+func (s *AgreementService) GetDocumentsImageUrls(ctx context.Context, agreementId string, opts *ImageUrlsOptions) (*AgreementDocumentImageUrls, error) {
+	u, err := addOptions(fmt.Sprintf("%s/%s/documents/imageUrls", agreementsPath, agreementId), opts)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var response *AgreementDocumentImageUrls
+	if _, err := s.client.Do(ctx, req, &response); err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+// GetDocumentImageUrls retrieves the urls of the page images of a document of an existing AdobeSign Agreement
+// ref: https://secure.na1.adobesign.com/public/docs/restapi/v6#!/agreements/getDocumentImageUrls
+func (s *AgreementService) GetDocumentImageUrls(ctx context.Context, agreementId, documentId string, opts *DocumentImageUrlsOptions) ([]ImageUrl, error) {
+	u, err := addOptions(fmt.Sprintf("%s/%s/documents/%s/imageUrls", agreementsPath, agreementId, documentId), opts)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var response DocumentImageUrls
+	if _, err := s.client.Do(ctx, req, &response); err != nil {
+		return nil, err
+	}
+
+	return response.ImageUrls, nil
+}
+
+// UpdateMergeFields sets the default values of the form fields of an existing AdobeSign Agreement, see
+// MergeFieldInfos to build them from a struct
+// ref: https://secure.na1.adobesign.com/public/docs/restapi/v6#!/agreements/updateFormFieldsMergeInfo
+func (s *AgreementService) UpdateMergeFields(ctx context.Context, agreementId string, fields []MergeFieldInfo) error {
+	u := fmt.Sprintf("%s/%s/formFields/mergeInfo", agreementsPath, agreementId)
+
+	req, err := s.client.NewRequest("PUT", u, FormFieldMergeInfo{FieldMergeInfos: fields})
+	if err != nil {
+		return err
+	}
+
+	_, err = s.client.Do(ctx, req, nil)
+	return err
+}
+
+type DisplayUserInfo struct {
+	// Company Displays the name of the company of the user, if available
+	Company string `json:"company,omitempty"`
+	// Email Displays the email of the user
+	Email string `json:"email"`
+	// FullName Displays the full name of the user, if available
+	FullName string `json:"fullName,omitempty"`
+}
+
+type DisplayParticipantSetInfo struct {
+	// DisplayUserSetMemberInfos Displays the info about user set
+	DisplayUserSetMemberInfos []DisplayUserInfo `json:"displayUserSetMemberInfos"`
+	// DisplayUserSetName The name of the display user set. Returned only, if the API caller is the sender of agreement
+	DisplayUserSetName string `json:"displayUserSetName,omitempty"`
+}
+
+// AgreementInfo is the summary of an agreement returned by the endpoints that list agreements
+type AgreementInfo struct {
+	// DisplayDate The display date for the agreement
+	DisplayDate string `json:"displayDate,omitempty"`
+	// DisplayParticipantSetInfos The names and emails of the participant sets
+	DisplayParticipantSetInfos []DisplayParticipantSetInfo `json:"displayParticipantSetInfos,omitempty"`
+	// Esign True if this is an e-sign document
+	Esign bool `json:"esign,omitempty"`
+	// GroupId The unique identifier of the group to which the agreement belongs
+	GroupId string `json:"groupId,omitempty"`
+	// Hidden True if the agreement is hidden for the user
+	Hidden bool `json:"hidden,omitempty"`
+	// Id The unique identifier of the agreement
+	Id string `json:"id"`
+	// LatestVersionId A version ID which uniquely identifies the current version of the agreement
+	LatestVersionId string `json:"latestVersionId,omitempty"`
+	// Name of the agreement
+	Name string `json:"name"`
+	// ParentId The unique identifier of the widget or MegaSign the agreement was created from
+	ParentId string `json:"parentId,omitempty"`
+	// Status The current status of the agreement, see AgreementStatus
+	Status string `json:"status"`
+	// Type ['AGREEMENT' or 'MEGASIGN_CHILD' or 'WIDGET_INSTANCE']: Type of agreement
+	Type string `json:"type,omitempty"`
+}
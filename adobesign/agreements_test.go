@@ -0,0 +1,222 @@
+package adobesign
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"testing"
+)
+
+func TestDeleteDocuments(t *testing.T) {
+	tests := []struct {
+		name       string
+		opts       *DeleteDocumentsOptions
+		agreement  string
+		deleteCode string
+		wantGet    bool
+		wantDelete bool
+		wantErr    interface{}
+	}{
+		{
+			name:       "without checks",
+			wantDelete: true,
+		},
+		{
+			name:       "retention applied on delete",
+			deleteCode: "DOCUMENT_RETENTION_APPLIED",
+			wantDelete: true,
+			wantErr:    new(*DocumentRetentionAppliedError),
+		},
+		{
+			name:       "agreement in progress on delete",
+			deleteCode: "INVALID_AGREEMENT_STATE",
+			wantDelete: true,
+			wantErr:    new(*AgreementNotTerminalError),
+		},
+		{
+			name:       "other delete error",
+			deleteCode: "PERMISSION_DENIED",
+			wantDelete: true,
+			wantErr:    new(*ErrorResponse),
+		},
+		{
+			name:       "verified terminal state",
+			opts:       &DeleteDocumentsOptions{VerifyTerminalState: true},
+			agreement:  `{"id":"a1","status":"SIGNED"}`,
+			wantGet:    true,
+			wantDelete: true,
+		},
+		{
+			name:      "verified agreement in progress",
+			opts:      &DeleteDocumentsOptions{VerifyTerminalState: true},
+			agreement: `{"id":"a1","status":"OUT_FOR_SIGNATURE"}`,
+			wantGet:   true,
+			wantErr:   new(*AgreementNotTerminalError),
+		},
+		{
+			name:      "verified retention applied",
+			opts:      &DeleteDocumentsOptions{VerifyTerminalState: true},
+			agreement: `{"id":"a1","status":"SIGNED","isDocumentRetentionApplied":true}`,
+			wantGet:   true,
+			wantErr:   new(*DocumentRetentionAppliedError),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client, mux := setup(t)
+
+			got, deleted := false, false
+			mux.HandleFunc("/agreements/a1", func(w http.ResponseWriter, r *http.Request) {
+				got = true
+				fmt.Fprint(w, tt.agreement)
+			})
+			mux.HandleFunc("/agreements/a1/documents", func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodDelete {
+					t.Errorf("method = %s, want DELETE", r.Method)
+				}
+				deleted = true
+				if tt.deleteCode != "" {
+					http.Error(w, fmt.Sprintf(`{"code":%q,"message":"refused"}`, tt.deleteCode), http.StatusBadRequest)
+					return
+				}
+				w.WriteHeader(http.StatusNoContent)
+			})
+
+			err := client.AgreementService.DeleteDocuments(context.Background(), "a1", tt.opts)
+			if tt.wantErr == nil {
+				if err != nil {
+					t.Fatalf("DeleteDocuments() error = %v", err)
+				}
+			} else if !errors.As(err, tt.wantErr) {
+				t.Fatalf("DeleteDocuments() error = %v, want a %T", err, tt.wantErr)
+			}
+			if got != tt.wantGet {
+				t.Errorf("agreement retrieved = %v, want %v", got, tt.wantGet)
+			}
+			if deleted != tt.wantDelete {
+				t.Errorf("documents deleted = %v, want %v", deleted, tt.wantDelete)
+			}
+		})
+	}
+}
+
+func TestGetDocument(t *testing.T) {
+	client, mux := setup(t)
+
+	mux.HandleFunc("/agreements/a1/documents/d1", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/pdf")
+		fmt.Fprint(w, "%PDF-1.7")
+	})
+	mux.HandleFunc("/agreements/a1/documents/missing", func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, `{"code":"INVALID_DOCUMENT_ID","message":"not found"}`, http.StatusNotFound)
+	})
+
+	ctx := context.Background()
+	body, err := client.AgreementService.GetDocument(ctx, "a1", "d1")
+	if err != nil {
+		t.Fatalf("GetDocument() error = %v", err)
+	}
+	defer body.Close()
+	got, err := ioutil.ReadAll(body)
+	if err != nil {
+		t.Fatalf("reading document: %v", err)
+	}
+	if string(got) != "%PDF-1.7" {
+		t.Errorf("GetDocument() = %q, want %q", got, "%PDF-1.7")
+	}
+
+	body, err = client.AgreementService.GetDocument(ctx, "a1", "missing")
+	var errorResponse *ErrorResponse
+	if !errors.As(err, &errorResponse) || errorResponse.Code != "INVALID_DOCUMENT_ID" {
+		t.Errorf("GetDocument() error = %v, want an INVALID_DOCUMENT_ID *ErrorResponse", err)
+	}
+	if body != nil {
+		t.Errorf("GetDocument() body = %v, want nil on error", body)
+	}
+}
+
+func TestEditAndSendAgreement(t *testing.T) {
+	const draft = `{
+  "id": "a1",
+  "name": "Service Agreement",
+  "status": "DRAFT",
+  "createdDate": "2021-06-15T09:12:03Z",
+  "lastEventDate": "2021-06-15T09:12:03Z",
+  "senderEmail": "sender@example.com",
+  "signatureType": "ESIGN",
+  "hasFormFieldData": true,
+  "participantSetsInfo": [
+    {"id": "ps1", "order": 1, "role": "SIGNER", "memberInfos": [{"id": "m1", "email": "signer@example.com"}]}
+  ]
+}`
+
+	client, mux := setup(t)
+
+	var put map[string]interface{}
+	mux.HandleFunc("/agreements/a1", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			w.Header().Set(headerETag, "etag-1")
+			fmt.Fprint(w, draft)
+		case http.MethodPut:
+			if got := r.Header.Get(headerIfMatch); got != "etag-1" {
+				t.Errorf("%s header = %q, want etag-1", headerIfMatch, got)
+			}
+			if err := json.NewDecoder(r.Body).Decode(&put); err != nil {
+				t.Errorf("decoding request: %v", err)
+			}
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("method = %s, want GET or PUT", r.Method)
+		}
+	})
+
+	err := client.AgreementService.EditAndSendAgreement(context.Background(), "a1", func(agreement *Agreement) error {
+		agreement.Message = "Please sign"
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("EditAndSendAgreement() error = %v", err)
+	}
+
+	for _, field := range []string{"id", "status", "createdDate", "lastEventDate", "senderEmail", "hasFormFieldData"} {
+		if _, ok := put[field]; ok {
+			t.Errorf("read-only field %s was sent", field)
+		}
+	}
+	want := map[string]interface{}{
+		"name":          "Service Agreement",
+		"message":       "Please sign",
+		"signatureType": "ESIGN",
+		"state":         "IN_PROCESS",
+	}
+	for field, value := range want {
+		if put[field] != value {
+			t.Errorf("%s = %v, want %v", field, put[field], value)
+		}
+	}
+
+	sets, _ := put["participantSetsInfo"].([]interface{})
+	if len(sets) != 1 {
+		t.Fatalf("participantSetsInfo = %v, want one participant set", put["participantSetsInfo"])
+	}
+	set := sets[0].(map[string]interface{})
+	if _, ok := set["id"]; ok {
+		t.Errorf("participant set id was sent")
+	}
+	members, _ := set["memberInfos"].([]interface{})
+	if len(members) != 1 {
+		t.Fatalf("memberInfos = %v, want one member", set["memberInfos"])
+	}
+	member := members[0].(map[string]interface{})
+	if _, ok := member["id"]; ok {
+		t.Errorf("member id was sent")
+	}
+	if member["email"] != "signer@example.com" {
+		t.Errorf("member email = %v, want signer@example.com", member["email"])
+	}
+}
@@ -123,3 +123,452 @@ var SignatureType = struct {
 	Esign:   "ESIGN",
 	Written: "WRITTEN",
 }
+
+// AgreementEventType defines the types of events returned by the agreement events API. Where a webhook
+// subscription event exists for the same action, the field carries the same name without the `Agreement`
+// prefix (e.g. WebhookSubscriptionEvent.AgreementEmailViewed and AgreementEventType.EmailViewed).
+// Use AgreementEvent.WebhookSubscriptionEvent to map an event to its webhook counterpart.
+var AgreementEventType = struct {
+	Created                        string
+	Expired                        string
+	DocumentsDeleted               string
+	Recalled                       string
+	Rejected                       string
+	Shared                         string
+	ActionDelegated                string
+	ActionReplacedSigner           string
+	Modified                       string
+	UserAckAgreementModified       string
+	EmailViewed                    string
+	EmailBounced                   string
+	AutoCancelledConversionProblem string
+	OfflineSync                    string
+	UploadedBySender               string
+	Vaulted                        string
+	WebIdentityAuthenticated       string
+	KbaAuthenticated               string
+	SignatureRequested             string
+	ApprovalRequested              string
+	AcceptanceRequested            string
+	FormFillingRequested           string
+	DeliveryRequested              string
+	Signed                         string
+	ESigned                        string
+	DigSigned                      string
+	Approved                       string
+	Accepted                       string
+	FormFilled                     string
+	Delivered                      string
+	AutoDelegated                  string
+	ExpiredAutomatically           string
+	Other                          string
+}{
+	Created:                        "CREATED",
+	Expired:                        "EXPIRED",
+	DocumentsDeleted:               "DOCUMENTS_DELETED",
+	Recalled:                       "RECALLED",
+	Rejected:                       "REJECTED",
+	Shared:                         "SHARED",
+	ActionDelegated:                "DELEGATED",
+	ActionReplacedSigner:           "REPLACED_SIGNER",
+	Modified:                       "MODIFIED",
+	UserAckAgreementModified:       "USER_ACK_AGREEMENT_MODIFIED",
+	EmailViewed:                    "EMAIL_VIEWED",
+	EmailBounced:                   "EMAIL_BOUNCED",
+	AutoCancelledConversionProblem: "AUTO_CANCELLED_CONVERSION_PROBLEM",
+	OfflineSync:                    "OFFLINE_SYNC",
+	UploadedBySender:               "UPLOADED_BY_SENDER",
+	Vaulted:                        "VAULTED",
+	WebIdentityAuthenticated:       "WEB_IDENTITY_AUTHENTICATED",
+	KbaAuthenticated:               "KBA_AUTHENTICATED",
+	SignatureRequested:             "SIGNATURE_REQUESTED",
+	ApprovalRequested:              "APPROVAL_REQUESTED",
+	AcceptanceRequested:            "ACCEPTANCE_REQUESTED",
+	FormFillingRequested:           "FORM_FILLING_REQUESTED",
+	DeliveryRequested:              "DELIVERY_REQUESTED",
+	Signed:                         "SIGNED",
+	ESigned:                        "ESIGNED",
+	DigSigned:                      "DIGSIGNED",
+	Approved:                       "APPROVED",
+	Accepted:                       "ACCEPTED",
+	FormFilled:                     "FORM_FILLED",
+	Delivered:                      "DELIVERED",
+	AutoDelegated:                  "AUTO_DELEGATED",
+	ExpiredAutomatically:           "EXPIRED_AUTOMATICALLY",
+	Other:                          "OTHER",
+}
+
+// FormFieldInputType defines the valid input types of a FormField.
+var FormFieldInputType = struct {
+	TextField    string
+	Multiline    string
+	Password     string
+	Radio        string
+	Checkbox     string
+	DropDown     string
+	Listbox      string
+	Signature    string
+	PdfSignature string
+	Button       string
+	Block        string
+	FileChooser  string
+	Comb         string
+	InlineImage  string
+}{
+	TextField:    "TEXT_FIELD",
+	Multiline:    "MULTILINE",
+	Password:     "PASSWORD",
+	Radio:        "RADIO",
+	Checkbox:     "CHECKBOX",
+	DropDown:     "DROP_DOWN",
+	Listbox:      "LISTBOX",
+	Signature:    "SIGNATURE",
+	PdfSignature: "PDF_SIGNATURE",
+	Button:       "BUTTON",
+	Block:        "BLOCK",
+	FileChooser:  "FILE_CHOOSER",
+	Comb:         "COMB",
+	InlineImage:  "INLINE_IMAGE",
+}
+
+// FormFieldValidation defines the valid validation rules of a FormField.
+var FormFieldValidation = struct {
+	None       string
+	String     string
+	Number     string
+	Date       string
+	DateCustom string
+	Time       string
+	Zip        string
+	Phone      string
+	SocialSec  string
+	Email      string
+	Currency   string
+	Percent    string
+	Formula    string
+}{
+	None:       "NONE",
+	String:     "STRING",
+	Number:     "NUMBER",
+	Date:       "DATE",
+	DateCustom: "DATE_CUSTOM",
+	Time:       "TIME",
+	Zip:        "ZIP",
+	Phone:      "PHONE",
+	SocialSec:  "SOCIAL_SEC",
+	Email:      "EMAIL",
+	Currency:   "CURRENCY",
+	Percent:    "PERCENT",
+	Formula:    "FORMULA",
+}
+
+// FormFieldAssignee defines the special assignees of a FormField. Any other assignee is a participant set id.
+var FormFieldAssignee = struct {
+	Prefill string
+	Nobody  string
+}{
+	Prefill: "PREFILL",
+	Nobody:  "NOBODY",
+}
+
+// FormFieldConditionAction defines the actions of a FormFieldConditionalAction.
+var FormFieldConditionAction = struct {
+	Show    string
+	Hide    string
+	Enable  string
+	Disable string
+}{
+	Show:    "SHOW",
+	Hide:    "HIDE",
+	Enable:  "ENABLE",
+	Disable: "DISABLE",
+}
+
+// FormFieldConditionOperator defines the operators of a FormFieldPredicate.
+var FormFieldConditionOperator = struct {
+	Equals            string
+	NotEquals         string
+	LessThan          string
+	LessThanEquals    string
+	GreaterThan       string
+	GreaterThanEquals string
+	Contains          string
+	NotContains       string
+}{
+	Equals:            "EQUALS",
+	NotEquals:         "NOT_EQUALS",
+	LessThan:          "LESS_THAN",
+	LessThanEquals:    "LESS_THAN_EQUALS",
+	GreaterThan:       "GREATER_THAN",
+	GreaterThanEquals: "GREATER_THAN_EQUALS",
+	Contains:          "CONTAINS",
+	NotContains:       "NOT_CONTAINS",
+}
+
+// AgreementView defines the pages of an agreement that can be requested through the views API.
+var AgreementView = struct {
+	All       string
+	Authoring string
+	Document  string
+	Manage    string
+}{
+	All:       "ALL",
+	Authoring: "AUTHORING",
+	Document:  "DOCUMENT",
+	Manage:    "MANAGE",
+}
+
+// Visibility defines whether a resource is shown to or hidden from the calling user.
+var Visibility = struct {
+	Hide string
+	Show string
+}{
+	Hide: "HIDE",
+	Show: "SHOW",
+}
+
+// ReminderFrequency defines the valid frequencies of a reminder and of Agreement.ReminderFrequency.
+var ReminderFrequency = struct {
+	DailyUntilSigned         string
+	WeekdailyUntilSigned     string
+	EveryOtherDayUntilSigned string
+	EveryThirdDayUntilSigned string
+	EveryFifthDayUntilSigned string
+	WeeklyUntilSigned        string
+	Once                     string
+}{
+	DailyUntilSigned:         "DAILY_UNTIL_SIGNED",
+	WeekdailyUntilSigned:     "WEEKDAILY_UNTIL_SIGNED",
+	EveryOtherDayUntilSigned: "EVERY_OTHER_DAY_UNTIL_SIGNED",
+	EveryThirdDayUntilSigned: "EVERY_THIRD_DAY_UNTIL_SIGNED",
+	EveryFifthDayUntilSigned: "EVERY_FIFTH_DAY_UNTIL_SIGNED",
+	WeeklyUntilSigned:        "WEEKLY_UNTIL_SIGNED",
+	Once:                     "ONCE",
+}
+
+// ReminderStatus defines the valid statuses of a reminder.
+var ReminderStatus = struct {
+	Active   string
+	Canceled string
+	Complete string
+}{
+	Active:   "ACTIVE",
+	Canceled: "CANCELED",
+	Complete: "COMPLETE",
+}
+
+// ReminderStartCounter defines from when the delay of a reminder is counted.
+var ReminderStartCounter = struct {
+	AgreementAvailability string
+	ReminderCreation      string
+}{
+	AgreementAvailability: "AGREEMENT_AVAILABILITY",
+	ReminderCreation:      "REMINDER_CREATION",
+}
+
+// AgreementStatus defines the statuses reported in Agreement.Status.
+var AgreementStatus = struct {
+	OutForSignature              string
+	OutForDelivery               string
+	OutForAcceptance             string
+	OutForFormFilling            string
+	OutForApproval               string
+	Authoring                    string
+	Cancelled                    string
+	Signed                       string
+	Approved                     string
+	Delivered                    string
+	Accepted                     string
+	FormFilled                   string
+	Expired                      string
+	Archived                     string
+	Prefill                      string
+	WidgetWaitingForVerification string
+	Draft                        string
+	DocumentsNotYetProcessed     string
+	WaitingForFaxin              string
+	WaitingForVerification       string
+	WaitingForNotarization       string
+}{
+	OutForSignature:              "OUT_FOR_SIGNATURE",
+	OutForDelivery:               "OUT_FOR_DELIVERY",
+	OutForAcceptance:             "OUT_FOR_ACCEPTANCE",
+	OutForFormFilling:            "OUT_FOR_FORM_FILLING",
+	OutForApproval:               "OUT_FOR_APPROVAL",
+	Authoring:                    "AUTHORING",
+	Cancelled:                    "CANCELLED",
+	Signed:                       "SIGNED",
+	Approved:                     "APPROVED",
+	Delivered:                    "DELIVERED",
+	Accepted:                     "ACCEPTED",
+	FormFilled:                   "FORM_FILLED",
+	Expired:                      "EXPIRED",
+	Archived:                     "ARCHIVED",
+	Prefill:                      "PREFILL",
+	WidgetWaitingForVerification: "WIDGET_WAITING_FOR_VERIFICATION",
+	Draft:                        "DRAFT",
+	DocumentsNotYetProcessed:     "DOCUMENTS_NOT_YET_PROCESSED",
+	WaitingForFaxin:              "WAITING_FOR_FAXIN",
+	WaitingForVerification:       "WAITING_FOR_VERIFICATION",
+	WaitingForNotarization:       "WAITING_FOR_NOTARIZATION",
+}
+
+// ImageSize defines the sizes of the page images of a document.
+var ImageSize = struct {
+	FixedWidth50px  string
+	FixedWidth250px string
+	FixedWidth675px string
+	Zoom50Percent   string
+	Zoom75Percent   string
+	Zoom100Percent  string
+	Zoom125Percent  string
+	Zoom150Percent  string
+	Zoom200Percent  string
+}{
+	FixedWidth50px:  "FIXED_WIDTH_50px",
+	FixedWidth250px: "FIXED_WIDTH_250px",
+	FixedWidth675px: "FIXED_WIDTH_675px",
+	Zoom50Percent:   "ZOOM_50_PERCENT",
+	Zoom75Percent:   "ZOOM_75_PERCENT",
+	Zoom100Percent:  "ZOOM_100_PERCENT",
+	Zoom125Percent:  "ZOOM_125_PERCENT",
+	Zoom150Percent:  "ZOOM_150_PERCENT",
+	Zoom200Percent:  "ZOOM_200_PERCENT",
+}
+
+// LibraryDocumentState defines the valid states of a library document.
+var LibraryDocumentState = struct {
+	Authoring string
+	Active    string
+}{
+	Authoring: "AUTHORING",
+	Active:    "ACTIVE",
+}
+
+// SharingMode defines with whom a library document is shared.
+var SharingMode = struct {
+	User    string
+	Group   string
+	Account string
+	Global  string
+}{
+	User:    "USER",
+	Group:   "GROUP",
+	Account: "ACCOUNT",
+	Global:  "GLOBAL",
+}
+
+// TemplateType defines the ways a library document can be used.
+var TemplateType = struct {
+	Document       string
+	FormFieldLayer string
+}{
+	Document:       "DOCUMENT",
+	FormFieldLayer: "FORM_FIELD_LAYER",
+}
+
+// WidgetState defines the valid states of a widget. Widgets are created in the Authoring, Draft or Active state
+// and can be moved between the Active and Inactive states afterwards.
+var WidgetState = struct {
+	Active    string
+	Authoring string
+	Draft     string
+	Inactive  string
+}{
+	Active:    "ACTIVE",
+	Authoring: "AUTHORING",
+	Draft:     "DRAFT",
+	Inactive:  "INACTIVE",
+}
+
+// WidgetView defines the pages of a widget that can be requested through the views API.
+var WidgetView = struct {
+	All       string
+	Authoring string
+	Document  string
+	Manage    string
+}{
+	All:       "ALL",
+	Authoring: "AUTHORING",
+	Document:  "DOCUMENT",
+	Manage:    "MANAGE",
+}
+
+// MegaSignState defines the valid states of a MegaSign. MegaSigns are created in the Authoring, Draft or InProcess
+// state and can only be moved to the Cancelled state afterwards.
+var MegaSignState = struct {
+	Authoring string
+	Draft     string
+	InProcess string
+	Cancelled string
+}{
+	Authoring: "AUTHORING",
+	Draft:     "DRAFT",
+	InProcess: "IN_PROCESS",
+	Cancelled: "CANCELLED",
+}
+
+// UserState defines the states a user can be moved to.
+var UserState = struct {
+	Active   string
+	Inactive string
+}{
+	Active:   "ACTIVE",
+	Inactive: "INACTIVE",
+}
+
+// SearchScope defines the resources that can be searched.
+var SearchScope = struct {
+	AgreementAssets string
+}{
+	AgreementAssets: "AGREEMENT_ASSETS",
+}
+
+// SearchQueryableField defines the fields the text query of a search is matched against.
+var SearchQueryableField = struct {
+	AgreementName    string
+	ParticipantEmail string
+	ParticipantName  string
+	Message          string
+	Note             string
+	ExternalId       string
+	DocumentContent  string
+}{
+	AgreementName:    "AGREEMENT_NAME",
+	ParticipantEmail: "PARTICIPANT_EMAIL",
+	ParticipantName:  "PARTICIPANT_NAME",
+	Message:          "MESSAGE",
+	Note:             "NOTE",
+	ExternalId:       "EXTERNAL_ID",
+	DocumentContent:  "DOCUMENT_CONTENT",
+}
+
+// WebhookState defines the valid states of a webhook.
+var WebhookState = struct {
+	Active   string
+	Inactive string
+}{
+	Active:   "ACTIVE",
+	Inactive: "INACTIVE",
+}
+
+// AuthenticationMethod defines the methods participants can be required to authenticate with.
+var AuthenticationMethod = struct {
+	None        string
+	Password    string
+	Phone       string
+	Kba         string
+	WebIdentity string
+	AdobeSign   string
+	GovId       string
+	DigId       string
+}{
+	None:        "NONE",
+	Password:    "PASSWORD",
+	Phone:       "PHONE",
+	Kba:         "KBA",
+	WebIdentity: "WEB_IDENTITY",
+	AdobeSign:   "ADOBE_SIGN",
+	GovId:       "GOV_ID",
+	DigId:       "DIG_ID",
+}
@@ -0,0 +1,230 @@
+package adobesign
+
+import (
+	"encoding"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// structTagName is the struct tag used to map Go struct fields to Adobe Sign form fields.
+const structTagName = "adobesign"
+
+// Columns added by Adobe Sign to every form data export besides the form fields themselves.
+const (
+	formDataColumnCompleted   = "completed"
+	formDataColumnEmail       = "email"
+	formDataColumnRole        = "role"
+	formDataColumnFirst       = "first"
+	formDataColumnLast        = "last"
+	formDataColumnTitle       = "title"
+	formDataColumnCompany     = "company"
+	formDataColumnAgreementId = "agreementId"
+)
+
+// FormDataRow holds the values captured from a single participant while signing
+// ref: https://secure.na1.adobesign.com/public/docs/restapi/v6#!/agreements/getFormData
+type FormDataRow struct {
+	// AgreementId The unique identifier of the agreement the values were captured on
+	AgreementId string
+	// Completed The date when the participant completed the agreement
+	Completed string
+	// ParticipantEmail Email of the participant that filled the fields
+	ParticipantEmail string
+	// ParticipantRole Role of the participant that filled the fields
+	ParticipantRole string
+	// FirstName of the participant, if available
+	FirstName string
+	// LastName of the participant, if available
+	LastName string
+	// Title of the participant, if available
+	Title string
+	// Company of the participant, if available
+	Company string
+	// Fields The values of the form fields keyed by field name
+	Fields map[string]string
+}
+
+// parseFormData parses the CSV returned by the form data endpoints into FormDataRows.
+func parseFormData(r io.Reader) ([]FormDataRow, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+
+	header, err := reader.Read()
+	if err == io.EOF {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if len(header) > 0 {
+		// Exports may start with a UTF-8 byte order mark.
+		header[0] = strings.TrimPrefix(header[0], "\ufeff")
+	}
+
+	// Form fields may share their name with a metadata column, e.g. a company field. Adobe Sign puts the metadata
+	// columns first, so only the first column of each name is metadata.
+	metadata := make([]bool, len(header))
+	seen := map[string]bool{}
+	for i, column := range header {
+		switch column {
+		case formDataColumnCompleted, formDataColumnEmail, formDataColumnRole, formDataColumnFirst,
+			formDataColumnLast, formDataColumnTitle, formDataColumnCompany, formDataColumnAgreementId:
+			metadata[i] = !seen[column]
+			seen[column] = true
+		}
+	}
+
+	var rows []FormDataRow
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		row := FormDataRow{Fields: map[string]string{}}
+		for i, column := range header {
+			if i >= len(record) {
+				break
+			}
+			value := record[i]
+			if !metadata[i] {
+				row.Fields[column] = value
+				continue
+			}
+			switch column {
+			case formDataColumnCompleted:
+				row.Completed = value
+			case formDataColumnEmail:
+				row.ParticipantEmail = value
+			case formDataColumnRole:
+				row.ParticipantRole = value
+			case formDataColumnFirst:
+				row.FirstName = value
+			case formDataColumnLast:
+				row.LastName = value
+			case formDataColumnTitle:
+				row.Title = value
+			case formDataColumnCompany:
+				row.Company = value
+			case formDataColumnAgreementId:
+				row.AgreementId = value
+			}
+		}
+		rows = append(rows, row)
+	}
+
+	return rows, nil
+}
+
+// UnmarshalFormData stores the field values of row in the struct pointed to by v. Struct fields are matched
+// to form fields through the `adobesign:"fieldName"` tag, untagged fields and fields tagged with "-" are
+// skipped. Supported field types are strings, booleans, integers, floats, pointers to those and types
+// implementing encoding.TextUnmarshaler. Empty form field values leave the struct field untouched.
+func UnmarshalFormData(row FormDataRow, v interface{}) error {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Ptr || rv.IsNil() || rv.Elem().Kind() != reflect.Struct {
+		return errors.New("adobesign: UnmarshalFormData requires a non-nil pointer to a struct")
+	}
+	rv = rv.Elem()
+	rt := rv.Type()
+
+	for i := 0; i < rt.NumField(); i++ {
+		field := rt.Field(i)
+		name := tagFieldName(field)
+		if name == "" || field.PkgPath != "" {
+			continue
+		}
+
+		value, ok := row.Fields[name]
+		if !ok || value == "" {
+			continue
+		}
+
+		if err := setFormValue(rv.Field(i), value); err != nil {
+			return fmt.Errorf("adobesign: form field %q: %v", name, err)
+		}
+	}
+
+	return nil
+}
+
+// tagFieldName returns the form field name from the adobesign tag of field, or an empty string if the
+// field should be skipped.
+func tagFieldName(field reflect.StructField) string {
+	tag := field.Tag.Get(structTagName)
+	if i := strings.Index(tag, ","); i >= 0 {
+		tag = tag[:i]
+	}
+	if tag == "-" {
+		return ""
+	}
+	return tag
+}
+
+// setFormValue parses value into the type of v and stores it.
+func setFormValue(v reflect.Value, value string) error {
+	if v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			v.Set(reflect.New(v.Type().Elem()))
+		}
+		return setFormValue(v.Elem(), value)
+	}
+
+	if v.CanAddr() {
+		if u, ok := v.Addr().Interface().(encoding.TextUnmarshaler); ok {
+			return u.UnmarshalText([]byte(value))
+		}
+	}
+
+	switch v.Kind() {
+	case reflect.String:
+		v.SetString(value)
+	case reflect.Bool:
+		b, err := parseFormBool(value)
+		if err != nil {
+			return err
+		}
+		v.SetBool(b)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := strconv.ParseInt(strings.TrimSpace(value), 10, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetInt(n)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		n, err := strconv.ParseUint(strings.TrimSpace(value), 10, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetUint(n)
+	case reflect.Float32, reflect.Float64:
+		f, err := strconv.ParseFloat(strings.TrimSpace(value), v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetFloat(f)
+	default:
+		return fmt.Errorf("unsupported type %s", v.Type())
+	}
+
+	return nil
+}
+
+// parseFormBool parses the values Adobe Sign uses for checkboxes besides the ones strconv.ParseBool accepts.
+func parseFormBool(value string) (bool, error) {
+	switch strings.ToLower(strings.TrimSpace(value)) {
+	case "yes", "on", "checked", "x":
+		return true, nil
+	case "no", "off", "unchecked":
+		return false, nil
+	}
+	return strconv.ParseBool(value)
+}
@@ -0,0 +1,191 @@
+package adobesign
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestParseFormData(t *testing.T) {
+	tests := []struct {
+		name string
+		csv  string
+		want []FormDataRow
+	}{
+		{
+			name: "empty",
+			csv:  "",
+			want: nil,
+		},
+		{
+			name: "header only",
+			csv:  "completed,email,role\n",
+			want: nil,
+		},
+		{
+			name: "metadata and fields",
+			csv: "completed,email,role,first,last,title,company,agreementId,amount\n" +
+				"2021-06-15 09:31:24,jane@example.com,SIGNER,Jane,Doe,CTO,Acme,CBJCHBCAABAA5Q5m,100\n",
+			want: []FormDataRow{{
+				AgreementId:      "CBJCHBCAABAA5Q5m",
+				Completed:        "2021-06-15 09:31:24",
+				ParticipantEmail: "jane@example.com",
+				ParticipantRole:  "SIGNER",
+				FirstName:        "Jane",
+				LastName:         "Doe",
+				Title:            "CTO",
+				Company:          "Acme",
+				Fields:           map[string]string{"amount": "100"},
+			}},
+		},
+		{
+			name: "byte order mark",
+			csv:  "\ufeffcompleted,email,amount\n2021-06-15,jane@example.com,100\n",
+			want: []FormDataRow{{
+				Completed:        "2021-06-15",
+				ParticipantEmail: "jane@example.com",
+				Fields:           map[string]string{"amount": "100"},
+			}},
+		},
+		{
+			name: "short row",
+			csv:  "completed,email,amount,notes\n2021-06-15,jane@example.com\n",
+			want: []FormDataRow{{
+				Completed:        "2021-06-15",
+				ParticipantEmail: "jane@example.com",
+				Fields:           map[string]string{},
+			}},
+		},
+		{
+			name: "form fields named like metadata columns",
+			csv: "completed,email,company,email,company\n" +
+				"2021-06-15,jane@example.com,Acme,billing@example.com,Acme Holdings\n",
+			want: []FormDataRow{{
+				Completed:        "2021-06-15",
+				ParticipantEmail: "jane@example.com",
+				Company:          "Acme",
+				Fields:           map[string]string{"email": "billing@example.com", "company": "Acme Holdings"},
+			}},
+		},
+		{
+			name: "multiple participants",
+			csv:  "email,role,amount\njane@example.com,SIGNER,100\njohn@example.com,APPROVER,\n",
+			want: []FormDataRow{
+				{ParticipantEmail: "jane@example.com", ParticipantRole: "SIGNER", Fields: map[string]string{"amount": "100"}},
+				{ParticipantEmail: "john@example.com", ParticipantRole: "APPROVER", Fields: map[string]string{"amount": ""}},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseFormData(strings.NewReader(tt.csv))
+			if err != nil {
+				t.Fatalf("parseFormData() error = %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("parseFormData() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+type formDataTestStruct struct {
+	Name       string    `adobesign:"name"`
+	Amount     int       `adobesign:"amount"`
+	Rate       float64   `adobesign:"rate"`
+	Agree      bool      `adobesign:"agree"`
+	Count      *uint     `adobesign:"count"`
+	Signed     time.Time `adobesign:"signed"`
+	Company    string    `adobesign:"company,omitempty"`
+	Skipped    string    `adobesign:"-"`
+	Untagged   string
+	unexported string `adobesign:"name"`
+}
+
+func TestUnmarshalFormData(t *testing.T) {
+	count := uint(3)
+
+	tests := []struct {
+		name    string
+		fields  map[string]string
+		want    formDataTestStruct
+		wantErr bool
+	}{
+		{
+			name: "all types",
+			fields: map[string]string{
+				"name":    "Jane",
+				"amount":  " 42 ",
+				"rate":    "0.5",
+				"agree":   "true",
+				"count":   "3",
+				"signed":  "2021-06-15T09:31:24Z",
+				"company": "Acme",
+			},
+			want: formDataTestStruct{
+				Name:    "Jane",
+				Amount:  42,
+				Rate:    0.5,
+				Agree:   true,
+				Count:   &count,
+				Signed:  time.Date(2021, 6, 15, 9, 31, 24, 0, time.UTC),
+				Company: "Acme",
+			},
+		},
+		{
+			name:   "checkbox values",
+			fields: map[string]string{"agree": "Yes"},
+			want:   formDataTestStruct{Agree: true},
+		},
+		{
+			name:   "empty values are skipped",
+			fields: map[string]string{"name": "", "amount": ""},
+			want:   formDataTestStruct{},
+		},
+		{
+			name:   "skipped and untagged fields",
+			fields: map[string]string{"-": "x", "Skipped": "x", "Untagged": "x"},
+			want:   formDataTestStruct{},
+		},
+		{
+			name:    "invalid integer",
+			fields:  map[string]string{"amount": "many"},
+			wantErr: true,
+		},
+		{
+			name:    "invalid checkbox",
+			fields:  map[string]string{"agree": "maybe"},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got formDataTestStruct
+			err := UnmarshalFormData(FormDataRow{Fields: tt.fields}, &got)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("UnmarshalFormData() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("UnmarshalFormData() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestUnmarshalFormDataRequiresStructPointer(t *testing.T) {
+	var s formDataTestStruct
+	var nilPointer *formDataTestStruct
+	name := "x"
+
+	for _, v := range []interface{}{nil, s, nilPointer, &name} {
+		if err := UnmarshalFormData(FormDataRow{}, v); err == nil {
+			t.Errorf("UnmarshalFormData(%T) error = nil, want an error", v)
+		}
+	}
+}
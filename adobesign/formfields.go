@@ -0,0 +1,136 @@
+package adobesign
+
+// FormFieldLocation defines the position of a form field on a page. Coordinates and sizes are in pixels,
+// measured from the bottom left corner of the page.
+type FormFieldLocation struct {
+	// Height of the form field in pixels
+	Height float64 `json:"height"`
+	// Left Number of pixels from the left of the page
+	Left float64 `json:"left"`
+	// PageNumber The page number where the form field is located (starting at 1)
+	PageNumber int `json:"pageNumber"`
+	// Top Number of pixels from the bottom of the page
+	Top float64 `json:"top"`
+	// Width of the form field in pixels
+	Width float64 `json:"width"`
+}
+
+type FormFieldHyperlink struct {
+	// DocumentLocation The location in the document to jump to, only used when LinkType is INTERNAL
+	DocumentLocation *FormFieldLocation `json:"documentLocation,omitempty"`
+	// LinkType ['INTERNAL' or 'EXTERNAL']: The type of the link
+	LinkType string `json:"linkType,omitempty"`
+	// Url An external URL, only used when LinkType is EXTERNAL
+	Url string `json:"url,omitempty"`
+}
+
+type FormFieldPredicate struct {
+	// FieldLocationIndex Index of the location of the form field used in the predicate
+	FieldLocationIndex int `json:"fieldLocationIndex,omitempty"`
+	// FieldName The name of the form field whose value is evaluated
+	FieldName string `json:"fieldName"`
+	// Operator The operator used to evaluate the field, see FormFieldConditionOperator
+	Operator string `json:"operator"`
+	// Value The value the field is compared with
+	Value string `json:"value"`
+}
+
+type FormFieldConditionalAction struct {
+	// Action The action to perform when the predicates match, see FormFieldConditionAction
+	Action string `json:"action,omitempty"`
+	// AnyOrAll ['ANY' or 'ALL']: Whether any or all of the predicates need to match
+	AnyOrAll string `json:"anyOrAll,omitempty"`
+	// Predicates The conditions that trigger the action
+	Predicates []FormFieldPredicate `json:"predicates,omitempty"`
+}
+
+// FormField defines a field that is placed on the documents of an agreement or a library document
+// ref: https://secure.na1.adobesign.com/public/docs/restapi/v6#!/agreements/updateFormFields
+type FormField struct {
+	// Alignment ['LEFT' or 'RIGHT' or 'CENTER']: Alignment of the text
+	Alignment string `json:"alignment,omitempty"`
+	// Assignee The id of the participant set that is assigned to fill the field. Use PREFILL for fields
+	//that the sender fills or NOBODY for fields nobody can fill
+	Assignee string `json:"assignee,omitempty"`
+	// BackgroundColor Background color of the form field in RGB or HEX format
+	BackgroundColor string `json:"backgroundColor,omitempty"`
+	// BorderColor Color of the border of the field in RGB or HEX format
+	BorderColor string `json:"borderColor,omitempty"`
+	// BorderStyle ['SOLID' or 'DASHED' or 'BEVELED' or 'INSET' or 'UNDERLINE']: Style of the border of the field
+	BorderStyle string `json:"borderStyle,omitempty"`
+	// BorderWidth Width of the border of the field in pixels
+	BorderWidth float64 `json:"borderWidth,omitempty"`
+	// Calculated True if the value of the field is calculated from ValueExpression
+	Calculated bool `json:"calculated,omitempty"`
+	// ConditionalAction Shows, hides, enables or disables the field based on the value of other fields
+	ConditionalAction *FormFieldConditionalAction `json:"conditionalAction,omitempty"`
+	// ContentType The content of the field, e.g. DATA, SIGNATURE, SIGNER_NAME or SIGNATURE_DATE
+	ContentType string `json:"contentType,omitempty"`
+	// Currency ISO 4217 code of the currency used when Validation is CURRENCY
+	Currency string `json:"currency,omitempty"`
+	// DefaultValue Default value of the form field. For radio buttons and checkboxes it must be one of the
+	//VisibleOptions
+	DefaultValue string `json:"defaultValue,omitempty"`
+	// DisplayFormat Format of the value displayed in the field, e.g. a date pattern
+	DisplayFormat string `json:"displayFormat,omitempty"`
+	// DisplayFormatType ['DEFAULT' or 'DATE' or 'NUMBER']: Type of the display format
+	DisplayFormatType string `json:"displayFormatType,omitempty"`
+	// DisplayLabel Display label attached to the field
+	DisplayLabel string `json:"displayLabel,omitempty"`
+	// FontColor Font color of the form field in RGB or HEX format
+	FontColor string `json:"fontColor,omitempty"`
+	// FontName Font name of the form field
+	FontName string `json:"fontName,omitempty"`
+	// FontSize Font size of the form field in points
+	FontSize float64 `json:"fontSize,omitempty"`
+	// HiddenOptions Values submitted for each of the VisibleOptions of radio buttons, drop downs and list boxes
+	HiddenOptions []string `json:"hiddenOptions,omitempty"`
+	// Hyperlink Target of the field when InputType is BUTTON
+	Hyperlink *FormFieldHyperlink `json:"hyperlink,omitempty"`
+	// InputType Type of the form field, see FormFieldInputType
+	InputType string `json:"inputType,omitempty"`
+	// Locations The locations of the field in the documents. Radio groups have one location per button
+	Locations []FormFieldLocation `json:"locations"`
+	// Masked True if the value of the field is masked while it is typed, e.g. for social security numbers
+	Masked bool `json:"masked,omitempty"`
+	// MaskingText Text used to mask the value when Masked is true
+	MaskingText string `json:"maskingText,omitempty"`
+	// MaxLength Maximum length of the value, only for text fields
+	MaxLength int `json:"maxLength,omitempty"`
+	// MaxValue Maximum value of the field, only for numeric validations. Set it with Float64
+	MaxValue *float64 `json:"maxValue,omitempty"`
+	// MinLength Minimum length of the value, only for text fields
+	MinLength int `json:"minLength,omitempty"`
+	// MinValue Minimum value of the field, only for numeric validations. Set it with Float64
+	MinValue *float64 `json:"minValue,omitempty"`
+	// Name of the form field. Fields with the same name share their value, this is how radio groups are built
+	Name string `json:"name"`
+	// Origin ['AUTHORED' or 'GENERATED' or 'IMPORTED']: Origin of the form field
+	Origin string `json:"origin,omitempty"`
+	// RadioCheckType ['CIRCLE' or 'CHECK' or 'CROSS' or 'DIAMOND' or 'SQUARE' or 'STAR']: Shape of the radio
+	//buttons and check boxes
+	RadioCheckType string `json:"radioCheckType,omitempty"`
+	// ReadOnly True if the field cannot be modified
+	ReadOnly bool `json:"readOnly,omitempty"`
+	// Required True if the field must be filled
+	Required bool `json:"required,omitempty"`
+	// Tooltip Text shown when hovering over the field
+	Tooltip string `json:"tooltip,omitempty"`
+	// Validation Rule used to validate the value of the field, see FormFieldValidation
+	Validation string `json:"validation,omitempty"`
+	// ValidationData Additional data for the validation, e.g. a date format or a regular expression
+	ValidationData string `json:"validationData,omitempty"`
+	// ValidationErrMsg Error message shown when the validation fails
+	ValidationErrMsg string `json:"validationErrMsg,omitempty"`
+	// ValueExpression Expression used to compute the value of calculated fields
+	ValueExpression string `json:"valueExpression,omitempty"`
+	// Visible True if the field is visible, which Adobe Sign defaults to. Set it with Bool
+	Visible *bool `json:"visible,omitempty"`
+	// VisibleOptions Options shown to the user for radio buttons, drop downs and list boxes
+	VisibleOptions []string `json:"visibleOptions,omitempty"`
+}
+
+type FormFieldList struct {
+	// Fields The form fields of the documents
+	Fields []FormField `json:"fields"`
+}
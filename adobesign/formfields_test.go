@@ -0,0 +1,48 @@
+package adobesign
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestFormFieldMarshalJSON(t *testing.T) {
+	tests := []struct {
+		name  string
+		field FormField
+		want  string
+	}{
+		{
+			name:  "optional fields unset",
+			field: FormField{Name: "amount"},
+			want:  `{"name":"amount","locations":[{"height":20,"left":72,"pageNumber":1,"top":700,"width":144}]}`,
+		},
+		{
+			name:  "hidden field",
+			field: FormField{Name: "amount", Visible: Bool(false)},
+			want:  `{"name":"amount","visible":false,"locations":[{"height":20,"left":72,"pageNumber":1,"top":700,"width":144}]}`,
+		},
+		{
+			name:  "zero bounds",
+			field: FormField{Name: "amount", MinValue: Float64(0), MaxValue: Float64(0)},
+			want:  `{"name":"amount","maxValue":0,"minValue":0,"locations":[{"height":20,"left":72,"pageNumber":1,"top":700,"width":144}]}`,
+		},
+		{
+			name:  "external hyperlink",
+			field: FormField{Name: "link", Hyperlink: &FormFieldHyperlink{LinkType: "EXTERNAL", Url: "https://example.com"}},
+			want:  `{"name":"link","hyperlink":{"linkType":"EXTERNAL","url":"https://example.com"},"locations":[{"height":20,"left":72,"pageNumber":1,"top":700,"width":144}]}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.field.Locations = []FormFieldLocation{{Height: 20, Left: 72, PageNumber: 1, Top: 700, Width: 144}}
+			got, err := json.Marshal(tt.field)
+			if err != nil {
+				t.Fatalf("Marshal() error = %v", err)
+			}
+			if !jsonEqual(t, got, []byte(tt.want)) {
+				t.Errorf("Marshal() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,300 @@
+package adobesign
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+const groupsPath = "groups"
+
+// GroupService handles operations related to the groups of an account. Groups control what their users can create
+// and send.
+//
+// ref: https://secure.na1.adobesign.com/public/docs/restapi/v6#!/groups
+type GroupService service
+
+type GroupInfo struct {
+	// CreatedDate Date when the group was created
+	CreatedDate string `json:"createdDate,omitempty"`
+	// GroupId The unique identifier of the group
+	GroupId string `json:"groupId"`
+	// GroupName The name of the group
+	GroupName string `json:"groupName"`
+	// IsDefaultGroup True if this is the default group of the account
+	IsDefaultGroup bool `json:"isDefaultGroup"`
+}
+
+type GroupsInfo struct {
+	// GroupInfoList An array of groups
+	GroupInfoList []GroupInfo `json:"groupInfoList"`
+	// Page Pagination information for navigating through the response
+	Page PageInfo `json:"page"`
+}
+
+// Group defines the request body for creating and updating a group
+// ref: https://secure.na1.adobesign.com/public/docs/restapi/v6#!/groups/createGroup
+type Group struct {
+	// Name The name of the group
+	Name string `json:"name"`
+	// Created Date when the group was created. This will be ignored as part of POST or PUT calls
+	Created string `json:"created,omitempty"`
+	// Id The unique identifier of the group. This will be ignored as part of POST or PUT calls
+	Id string `json:"id,omitempty"`
+	// IsDefaultGroup True if this is the default group of the account. This will be ignored as part of POST or PUT
+	//calls
+	IsDefaultGroup bool `json:"isDefaultGroup,omitempty"`
+}
+
+type CreateGroupResponse struct {
+	Id string `json:"id"`
+}
+
+// BooleanSetting is a group setting that is either set on the group or inherited from the account.
+type BooleanSetting struct {
+	// Inherited True if the group uses the value set on the account. Set it to true to drop the value of the group
+	Inherited *bool `json:"inherited,omitempty"`
+	// Value of the setting
+	Value *bool `json:"value,omitempty"`
+}
+
+// Enabled reports whether the setting is set to true.
+func (s *BooleanSetting) Enabled() bool {
+	return s != nil && s.Value != nil && *s.Value
+}
+
+// GroupSettings defines the settings of a group. Every setting is optional: settings left nil are not sent by
+// UpdateGroupSettings and keep their current value. Settings returned by Adobe Sign that are not modelled here are
+// kept in Other, so settings that are read, modified and sent back are not reset.
+// ref: https://secure.na1.adobesign.com/public/docs/restapi/v6#!/groups/getGroupSettings
+type GroupSettings struct {
+	// LibraryDocumentCreationVisible True if the users of the group can create library documents
+	LibraryDocumentCreationVisible *BooleanSetting `json:"libaryDocumentCreationVisible,omitempty"`
+	// SendRestrictedToWorkflows True if the users of the group can only send agreements through workflows
+	SendRestrictedToWorkflows *BooleanSetting `json:"sendRestrictedToWorkflows,omitempty"`
+	// WidgetCreationVisible True if the users of the group can create web forms
+	WidgetCreationVisible *BooleanSetting `json:"widgetCreationVisible,omitempty"`
+	// Other The settings that are not modelled above, keyed by their JSON name
+	Other map[string]json.RawMessage `json:"-"`
+}
+
+// groupSettings has the fields of GroupSettings without its JSON methods.
+type groupSettings GroupSettings
+
+// UnmarshalJSON decodes the modelled settings into their fields and keeps the others in Other.
+func (s *GroupSettings) UnmarshalJSON(data []byte) error {
+	var settings groupSettings
+	if err := json.Unmarshal(data, &settings); err != nil {
+		return err
+	}
+
+	var all map[string]json.RawMessage
+	if err := json.Unmarshal(data, &all); err != nil {
+		return err
+	}
+	for _, name := range groupSettingNames() {
+		delete(all, name)
+	}
+	if len(all) > 0 {
+		settings.Other = all
+	}
+
+	*s = GroupSettings(settings)
+	return nil
+}
+
+// MarshalJSON encodes the modelled settings that are set together with the settings in Other.
+func (s GroupSettings) MarshalJSON() ([]byte, error) {
+	data, err := json.Marshal(groupSettings(s))
+	if err != nil || len(s.Other) == 0 {
+		return data, err
+	}
+
+	all := make(map[string]json.RawMessage, len(s.Other))
+	for name, value := range s.Other {
+		all[name] = value
+	}
+	if err := json.Unmarshal(data, &all); err != nil {
+		return nil, err
+	}
+	return json.Marshal(all)
+}
+
+// groupSettingNames returns the JSON names of the settings modelled by GroupSettings.
+func groupSettingNames() []string {
+	var names []string
+	t := reflect.TypeOf(groupSettings{})
+	for i := 0; i < t.NumField(); i++ {
+		name := strings.Split(t.Field(i).Tag.Get("json"), ",")[0]
+		if name != "" && name != "-" {
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
+// ListGroups retrieves a page of the groups of the account
+// ref: https://secure.na1.adobesign.com/public/docs/restapi/v6#!/groups/getGroups
+func (s *GroupService) ListGroups(ctx context.Context, opts *ListOptions) (*GroupsInfo, error) {
+	u, err := addOptions(groupsPath, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var response *GroupsInfo
+	if _, err := s.client.Do(ctx, req, &response); err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+// CreateGroup creates a new group in the account
+// ref: https://secure.na1.adobesign.com/public/docs/restapi/v6#!/groups/createGroup
+func (s *GroupService) CreateGroup(ctx context.Context, request Group) (*CreateGroupResponse, error) {
+	req, err := s.client.NewRequest("POST", groupsPath, request)
+	if err != nil {
+		return nil, err
+	}
+
+	var response *CreateGroupResponse
+	if _, err := s.client.Do(ctx, req, &response); err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+// GetGroup retrieves an existing group
+// ref: https://secure.na1.adobesign.com/public/docs/restapi/v6#!/groups/getGroupInfo
+func (s *GroupService) GetGroup(ctx context.Context, groupId string) (*Group, error) {
+	u := fmt.Sprintf("%s/%s", groupsPath, groupId)
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var response *Group
+	if _, err := s.client.Do(ctx, req, &response); err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+// UpdateGroup renames an existing group
+// ref: https://secure.na1.adobesign.com/public/docs/restapi/v6#!/groups/modifyGroup
+func (s *GroupService) UpdateGroup(ctx context.Context, groupId string, request Group) error {
+	u := fmt.Sprintf("%s/%s", groupsPath, groupId)
+
+	req, err := s.client.NewRequest("PUT", u, request)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.client.Do(ctx, req, nil)
+	return err
+}
+
+// DeleteGroup deletes an existing group, which must not have any users
+// ref: https://secure.na1.adobesign.com/public/docs/restapi/v6#!/groups/deleteGroup
+func (s *GroupService) DeleteGroup(ctx context.Context, groupId string) error {
+	u := fmt.Sprintf("%s/%s", groupsPath, groupId)
+
+	req, err := s.client.NewRequest("DELETE", u, nil)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.client.Do(ctx, req, nil)
+	return err
+}
+
+// ListGroupUsers retrieves a page of the users of a group
+// ref: https://secure.na1.adobesign.com/public/docs/restapi/v6#!/groups/getUsersInGroup
+func (s *GroupService) ListGroupUsers(ctx context.Context, groupId string, opts *ListOptions) (*UserInfoList, error) {
+	u, err := addOptions(fmt.Sprintf("%s/%s/users", groupsPath, groupId), opts)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var response *UserInfoList
+	if _, err := s.client.Do(ctx, req, &response); err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+// GetGroupSettings retrieves the settings of a group
+// ref: https://secure.na1.adobesign.com/public/docs/restapi/v6#!/groups/getGroupSettings
+func (s *GroupService) GetGroupSettings(ctx context.Context, groupId string) (*GroupSettings, error) {
+	u := fmt.Sprintf("%s/%s/settings", groupsPath, groupId)
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var response *GroupSettings
+	if _, err := s.client.Do(ctx, req, &response); err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+// UpdateGroupSettings updates the settings of a group. Only the settings set in request are sent, the others keep
+// their current value.
+// ref: https://secure.na1.adobesign.com/public/docs/restapi/v6#!/groups/updateGroupSettings
+func (s *GroupService) UpdateGroupSettings(ctx context.Context, groupId string, request GroupSettings) error {
+	u := fmt.Sprintf("%s/%s/settings", groupsPath, groupId)
+
+	req, err := s.client.NewRequest("PUT", u, request)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.client.Do(ctx, req, nil)
+	return err
+}
+
+// GetGroupIdByName resolves the name of a group to its id by going through the groups of the account. It returns an
+// empty string if no group has that name, and ErrPaginationStalled if the groups cannot all be read because a page
+// repeats the cursor of the previous one.
+func (s *GroupService) GetGroupIdByName(ctx context.Context, name string) (string, error) {
+	opts := &ListOptions{}
+	for {
+		groups, err := s.ListGroups(ctx, opts)
+		if err != nil {
+			return "", err
+		}
+
+		for _, group := range groups.GroupInfoList {
+			if group.GroupName == name {
+				return group.GroupId, nil
+			}
+		}
+
+		if groups.Page.NextCursor == "" {
+			return "", nil
+		}
+		if groups.Page.NextCursor == opts.Cursor {
+			return "", ErrPaginationStalled
+		}
+		opts.Cursor = groups.Page.NextCursor
+	}
+}
@@ -0,0 +1,169 @@
+package adobesign
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+// groupSettingsResponse is a group settings response in the documented format, including settings GroupSettings
+// does not model.
+const groupSettingsResponse = `{
+  "libaryDocumentCreationVisible": {"inherited": true, "value": true},
+  "sendRestrictedToWorkflows": {"inherited": false, "value": false},
+  "widgetCreationVisible": {"inherited": false, "value": true},
+  "userCanSend": {"inherited": true, "value": true},
+  "agreementViewLimit": {"inherited": false, "value": 25}
+}`
+
+// jsonEqual reports whether a and b hold the same JSON value.
+func jsonEqual(t *testing.T, a, b []byte) bool {
+	t.Helper()
+	var va, vb interface{}
+	if err := json.Unmarshal(a, &va); err != nil {
+		t.Fatalf("decoding %s: %v", a, err)
+	}
+	if err := json.Unmarshal(b, &vb); err != nil {
+		t.Fatalf("decoding %s: %v", b, err)
+	}
+	return reflect.DeepEqual(va, vb)
+}
+
+func TestGroupSettingsRoundTrip(t *testing.T) {
+	client, mux := setup(t)
+
+	var put []byte
+	mux.HandleFunc("/groups/g1/settings", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			fmt.Fprint(w, groupSettingsResponse)
+		case http.MethodPut:
+			put, _ = ioutil.ReadAll(r.Body)
+			w.WriteHeader(http.StatusNoContent)
+		}
+	})
+
+	ctx := context.Background()
+	settings, err := client.GroupService.GetGroupSettings(ctx, "g1")
+	if err != nil {
+		t.Fatalf("GetGroupSettings() error = %v", err)
+	}
+
+	if !settings.LibraryDocumentCreationVisible.Enabled() || *settings.LibraryDocumentCreationVisible.Inherited != true {
+		t.Errorf("LibraryDocumentCreationVisible = %+v, want an inherited true value", settings.LibraryDocumentCreationVisible)
+	}
+	if settings.SendRestrictedToWorkflows.Enabled() {
+		t.Errorf("SendRestrictedToWorkflows.Enabled() = true, want false")
+	}
+	if !settings.WidgetCreationVisible.Enabled() {
+		t.Errorf("WidgetCreationVisible.Enabled() = false, want true")
+	}
+	if len(settings.Other) != 2 || settings.Other["userCanSend"] == nil || settings.Other["agreementViewLimit"] == nil {
+		t.Errorf("Other = %s, want userCanSend and agreementViewLimit", settings.Other)
+	}
+
+	if err := client.GroupService.UpdateGroupSettings(ctx, "g1", *settings); err != nil {
+		t.Fatalf("UpdateGroupSettings() error = %v", err)
+	}
+	if !jsonEqual(t, put, []byte(groupSettingsResponse)) {
+		t.Errorf("UpdateGroupSettings() sent %s, want the settings that were read", put)
+	}
+}
+
+func TestGroupSettingsMarshalJSON(t *testing.T) {
+	tests := []struct {
+		name     string
+		settings GroupSettings
+		want     string
+	}{
+		{
+			name:     "empty",
+			settings: GroupSettings{},
+			want:     `{}`,
+		},
+		{
+			name:     "false value is sent",
+			settings: GroupSettings{SendRestrictedToWorkflows: &BooleanSetting{Value: Bool(false)}},
+			want:     `{"sendRestrictedToWorkflows":{"value":false}}`,
+		},
+		{
+			name:     "inherit from account",
+			settings: GroupSettings{WidgetCreationVisible: &BooleanSetting{Inherited: Bool(true)}},
+			want:     `{"widgetCreationVisible":{"inherited":true}}`,
+		},
+		{
+			name: "other settings",
+			settings: GroupSettings{
+				LibraryDocumentCreationVisible: &BooleanSetting{Value: Bool(true)},
+				Other:                          map[string]json.RawMessage{"userCanSend": json.RawMessage(`{"value":false}`)},
+			},
+			want: `{"libaryDocumentCreationVisible":{"value":true},"userCanSend":{"value":false}}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := json.Marshal(tt.settings)
+			if err != nil {
+				t.Fatalf("Marshal() error = %v", err)
+			}
+			if !jsonEqual(t, got, []byte(tt.want)) {
+				t.Errorf("Marshal() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGetGroupIdByName(t *testing.T) {
+	tests := []struct {
+		name    string
+		pages   map[string]string
+		want    string
+		wantErr error
+	}{
+		{
+			name: "found on second page",
+			pages: map[string]string{
+				"":   `{"groupInfoList":[{"groupId":"g1","groupName":"Sales"}],"page":{"nextCursor":"c1"}}`,
+				"c1": `{"groupInfoList":[{"groupId":"g2","groupName":"Legal"}],"page":{}}`,
+			},
+			want: "g2",
+		},
+		{
+			name: "not found",
+			pages: map[string]string{
+				"": `{"groupInfoList":[{"groupId":"g1","groupName":"Sales"}],"page":{}}`,
+			},
+		},
+		{
+			name: "repeated cursor",
+			pages: map[string]string{
+				"":   `{"groupInfoList":[{"groupId":"g1","groupName":"Sales"}],"page":{"nextCursor":"c1"}}`,
+				"c1": `{"groupInfoList":[{"groupId":"g1","groupName":"Sales"}],"page":{"nextCursor":"c1"}}`,
+			},
+			wantErr: ErrPaginationStalled,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client, mux := setup(t)
+			mux.HandleFunc("/groups", func(w http.ResponseWriter, r *http.Request) {
+				fmt.Fprint(w, tt.pages[r.URL.Query().Get("cursor")])
+			})
+
+			got, err := client.GroupService.GetGroupIdByName(context.Background(), "Legal")
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("GetGroupIdByName() error = %v, want %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("GetGroupIdByName() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,358 @@
+package adobesign
+
+import (
+	"context"
+	"fmt"
+	"io"
+)
+
+const libraryDocumentsPath = "libraryDocuments"
+
+// LibraryDocumentService handles operations related to library documents (templates).
+//
+// ref: https://secure.na1.adobesign.com/public/docs/restapi/v6#!/libraryDocuments
+type LibraryDocumentService service
+
+// LibraryDocument defines the request body for creating a library document
+// ref: https://secure.na1.adobesign.com/public/docs/restapi/v6#!/libraryDocuments/createLibraryDocument
+type LibraryDocument struct {
+	// FileInfos A list of one or more files used to create the library document. Use TransientDocumentId to create it
+	//from uploaded documents
+	FileInfos []FileInfo `json:"fileInfos,omitempty"`
+	// Name The name of the library document that will be used to identify it, in emails, website and other places
+	Name string `json:"name,omitempty"`
+	// SharingMode ['USER' or 'GROUP' or 'ACCOUNT' or 'GLOBAL']: Specifies who should have access to this library
+	//document, see SharingMode
+	SharingMode string `json:"sharingMode,omitempty"`
+	// State ['AUTHORING' or 'ACTIVE']: State of the library document, see LibraryDocumentState
+	State string `json:"state,omitempty"`
+	// TemplateTypes A list of one or more library template types, see TemplateType
+	TemplateTypes []string `json:"templateTypes,omitempty"`
+	// CreatedDate Date when library document was created. This will be ignored as part of POST or PUT calls
+	CreatedDate string `json:"createdDate,omitempty"`
+	// CreatorEmail Email address of the library document creator. It will be ignored in POST/PUT requests
+	CreatorEmail string `json:"creatorEmail,omitempty"`
+	// GroupId The unique identifier of the group to which the library document belongs to
+	GroupId string `json:"groupId,omitempty"`
+	// Id The unique identifier that is used to refer to the library template
+	Id string `json:"id,omitempty"`
+	// IsDocumentRetentionApplied True if the document retention was applied on the library document
+	IsDocumentRetentionApplied bool `json:"isDocumentRetentionApplied,omitempty"`
+	// LastEventDate The date of the last event that occurred on the library document
+	LastEventDate string `json:"lastEventDate,omitempty"`
+	// OwnerEmail Email address of the library document owner
+	OwnerEmail string `json:"ownerEmail,omitempty"`
+	// Status ['AUTHORING' or 'ACTIVE' or 'REMOVED']: Status of the library document. It will be ignored in POST/PUT
+	//requests
+	Status string `json:"status,omitempty"`
+}
+
+type LibraryDocumentInfo struct {
+	// CreatorEmail Email address of the library document creator
+	CreatorEmail string `json:"creatorEmail"`
+	// GroupId The unique identifier of the group to which the library document belongs
+	GroupId string `json:"groupId,omitempty"`
+	// Hidden True if the library document is hidden from the calling user
+	Hidden bool `json:"hidden,omitempty"`
+	// Id The unique identifier that is used to refer to the library template
+	Id string `json:"id"`
+	// ModifiedDate The day on which the library document was last modified
+	ModifiedDate string `json:"modifiedDate"`
+	// Name The name of the library document
+	Name string `json:"name"`
+	// SharingMode ['USER' or 'GROUP' or 'ACCOUNT' or 'GLOBAL']: Specifies who has access to this library document
+	SharingMode string `json:"sharingMode"`
+	// Status ['AUTHORING' or 'ACTIVE' or 'REMOVED']: Status of the library document
+	Status string `json:"status"`
+	// TemplateTypes A list of one or more library template types
+	TemplateTypes []string `json:"templateTypes"`
+}
+
+type LibraryDocuments struct {
+	// LibraryDocumentList An array of library documents
+	LibraryDocumentList []LibraryDocumentInfo `json:"libraryDocumentList"`
+	// Page Pagination information for navigating through the response
+	Page PageInfo `json:"page"`
+}
+
+// LibraryDocumentListOptions specifies the optional parameters of ListLibraryDocuments.
+type LibraryDocumentListOptions struct {
+	ListOptions
+
+	// ShowHiddenLibraryDocuments Also returns the library documents hidden by the calling user
+	ShowHiddenLibraryDocuments bool `url:"showHiddenLibraryDocuments,omitempty"`
+}
+
+type CreateLibraryDocumentResponse struct {
+	Id string `json:"id"`
+}
+
+type LibraryDocumentStateInfo struct {
+	// State ['AUTHORING' or 'ACTIVE']: The state to which the library document is to be updated
+	State string `json:"state"`
+}
+
+// ListLibraryDocuments retrieves a page of the library documents of the calling user
+// ref: https://secure.na1.adobesign.com/public/docs/restapi/v6#!/libraryDocuments/getLibraryDocuments
+func (s *LibraryDocumentService) ListLibraryDocuments(ctx context.Context, opts *LibraryDocumentListOptions) (*LibraryDocuments, error) {
+	u, err := addOptions(libraryDocumentsPath, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var response *LibraryDocuments
+	if _, err := s.client.Do(ctx, req, &response); err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+// CreateLibraryDocument creates a new library document, usually from transient documents
+// ref: https://secure.na1.adobesign.com/public/docs/restapi/v6#!/libraryDocuments/createLibraryDocument
+func (s *LibraryDocumentService) CreateLibraryDocument(ctx context.Context, request LibraryDocument) (*CreateLibraryDocumentResponse, error) {
+	req, err := s.client.NewRequest("POST", libraryDocumentsPath, request)
+	if err != nil {
+		return nil, err
+	}
+
+	var response *CreateLibraryDocumentResponse
+	if _, err := s.client.Do(ctx, req, &response); err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+// GetLibraryDocument retrieves an existing library document
+// ref: https://secure.na1.adobesign.com/public/docs/restapi/v6#!/libraryDocuments/getLibraryDocument
+func (s *LibraryDocumentService) GetLibraryDocument(ctx context.Context, libraryDocumentId string) (*LibraryDocument, error) {
+	response, _, err := s.GetLibraryDocumentWithETag(ctx, libraryDocumentId)
+	return response, err
+}
+
+// GetLibraryDocumentWithETag retrieves an existing library document together with its ETag, which must be provided
+// when updating the library document
+// ref: https://secure.na1.adobesign.com/public/docs/restapi/v6#!/libraryDocuments/getLibraryDocument
+func (s *LibraryDocumentService) GetLibraryDocumentWithETag(ctx context.Context, libraryDocumentId string) (*LibraryDocument, string, error) {
+	u := fmt.Sprintf("%s/%s", libraryDocumentsPath, libraryDocumentId)
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, "", err
+	}
+
+	var response *LibraryDocument
+	resp, err := s.client.Do(ctx, req, &response)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return response, resp.Header.Get(headerETag), nil
+}
+
+// UpdateLibraryDocument updates an existing library document. The etag returned by GetLibraryDocumentWithETag is
+// sent in the If-Match header so the update fails if the library document was modified in between.
+// ref: https://secure.na1.adobesign.com/public/docs/restapi/v6#!/libraryDocuments/updateLibraryDocument
+func (s *LibraryDocumentService) UpdateLibraryDocument(ctx context.Context, libraryDocumentId, etag string, request LibraryDocument) error {
+	u := fmt.Sprintf("%s/%s", libraryDocumentsPath, libraryDocumentId)
+
+	req, err := s.client.NewRequest("PUT", u, request)
+	if err != nil {
+		return err
+	}
+	if etag != "" {
+		req.Header.Set(headerIfMatch, etag)
+	}
+
+	_, err = s.client.Do(ctx, req, nil)
+	return err
+}
+
+// UpdateLibraryDocumentState moves a library document from AUTHORING to ACTIVE
+// ref: https://secure.na1.adobesign.com/public/docs/restapi/v6#!/libraryDocuments/updateLibraryDocumentState
+func (s *LibraryDocumentService) UpdateLibraryDocumentState(ctx context.Context, libraryDocumentId string, state string) error {
+	u := fmt.Sprintf("%s/%s/state", libraryDocumentsPath, libraryDocumentId)
+
+	req, err := s.client.NewRequest("PUT", u, LibraryDocumentStateInfo{State: state})
+	if err != nil {
+		return err
+	}
+
+	_, err = s.client.Do(ctx, req, nil)
+	return err
+}
+
+// UpdateLibraryDocumentVisibility hides or shows a library document in the library of the calling user
+// ref: https://secure.na1.adobesign.com/public/docs/restapi/v6#!/libraryDocuments/updateLibraryDocumentVisibility
+func (s *LibraryDocumentService) UpdateLibraryDocumentVisibility(ctx context.Context, libraryDocumentId string, visibility string) error {
+	u := fmt.Sprintf("%s/%s/me/visibility", libraryDocumentsPath, libraryDocumentId)
+
+	req, err := s.client.NewRequest("PUT", u, VisibilityInfo{Visibility: visibility})
+	if err != nil {
+		return err
+	}
+
+	_, err = s.client.Do(ctx, req, nil)
+	return err
+}
+
+type LibraryDocumentDocuments struct {
+	// Documents A list of documents of the library document
+	Documents []Document `json:"documents"`
+}
+
+// GetLibraryDocumentDocuments retrieves the IDs of the documents of a library document
+// ref: https://secure.na1.adobesign.com/public/docs/restapi/v6#!/libraryDocuments/getDocuments
+func (s *LibraryDocumentService) GetLibraryDocumentDocuments(ctx context.Context, libraryDocumentId string) ([]Document, error) {
+	u := fmt.Sprintf("%s/%s/documents", libraryDocumentsPath, libraryDocumentId)
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var response LibraryDocumentDocuments
+	if _, err := s.client.Do(ctx, req, &response); err != nil {
+		return nil, err
+	}
+
+	return response.Documents, nil
+}
+
+// GetDocument retrieves the file stream of a document of a library document. The caller must close the returned
+// stream
+// ref: https://secure.na1.adobesign.com/public/docs/restapi/v6#!/libraryDocuments/getDocument
+func (s *LibraryDocumentService) GetDocument(ctx context.Context, libraryDocumentId, documentId string) (io.ReadCloser, error) {
+	u := fmt.Sprintf("%s/%s/documents/%s", libraryDocumentsPath, libraryDocumentId, documentId)
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.DoStream(ctx, req)
+}
+
+// GetCombinedDocument retrieves a single combined PDF document for the documents associated with a library document.
+// The caller must close the returned stream
+// ref: https://secure.na1.adobesign.com/public/docs/restapi/v6#!/libraryDocuments/getCombinedDocument
+func (s *LibraryDocumentService) GetCombinedDocument(ctx context.Context, libraryDocumentId string) (io.ReadCloser, error) {
+	u := fmt.Sprintf("%s/%s/combinedDocument", libraryDocumentsPath, libraryDocumentId)
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.DoStream(ctx, req)
+}
+
+// GetAuditTrail retrieves the PDF file stream containing the audit trail of a library document. The caller must
+// close the returned stream
+// ref: https://secure.na1.adobesign.com/public/docs/restapi/v6#!/libraryDocuments/getAuditTrail
+func (s *LibraryDocumentService) GetAuditTrail(ctx context.Context, libraryDocumentId string) (io.ReadCloser, error) {
+	u := fmt.Sprintf("%s/%s/auditTrail", libraryDocumentsPath, libraryDocumentId)
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.DoStream(ctx, req)
+}
+
+// LibraryDocumentEvent describes a single entry in the history of a library document
+// ref: https://secure.na1.adobesign.com/public/docs/restapi/v6#!/libraryDocuments/getEvents
+type LibraryDocumentEvent struct {
+	// ActingUserEmail Email address of the user that initiated the event
+	ActingUserEmail string `json:"actingUserEmail,omitempty"`
+	// ActingUserIpAddress The IP address of the user that initiated the event
+	ActingUserIpAddress string `json:"actingUserIpAddress,omitempty"`
+	// ActingUserName Name of the user that initiated the event
+	ActingUserName string `json:"actingUserName,omitempty"`
+	// Comment Comment provided by the user that initiated the event
+	Comment string `json:"comment,omitempty"`
+	// Date The date of the audit event. Format would be yyyy-MM-dd'T'HH:mm:ssZ
+	Date string `json:"date,omitempty"`
+	// Description A description of the audit event
+	Description string `json:"description,omitempty"`
+	// DeviceLocation Location of the device that generated the event (This value may be null due to limited
+	//privileges)
+	DeviceLocation DeviceLocation `json:"deviceLocation,omitempty"`
+	// Id The unique identifier of the event
+	Id string `json:"id,omitempty"`
+	// InitiatingUserEmail Email address of the user that initiated the event on behalf of the acting user
+	InitiatingUserEmail string `json:"initiatingUserEmail,omitempty"`
+	// InitiatingUserName Full name of the user that initiated the event on behalf of the acting user
+	InitiatingUserName string `json:"initiatingUserName,omitempty"`
+	// Type ['CREATED' or 'MODIFIED' or 'DELETED' or 'SHARED' or 'AUTO_CANCELLED_CONVERSION_PROBLEM' or 'OTHER']: Type of
+	//the event
+	Type string `json:"type,omitempty"`
+	// VersionId An ID which uniquely identifies the version of the document associated with this audit event
+	VersionId string `json:"versionId,omitempty"`
+}
+
+type LibraryDocumentEvents struct {
+	// Events An ordered list of the events in the audit trail of this library document
+	Events []LibraryDocumentEvent `json:"events"`
+}
+
+// GetLibraryDocumentEvents retrieves the history of events of a library document
+// ref: https://secure.na1.adobesign.com/public/docs/restapi/v6#!/libraryDocuments/getEvents
+func (s *LibraryDocumentService) GetLibraryDocumentEvents(ctx context.Context, libraryDocumentId string) ([]LibraryDocumentEvent, error) {
+	u := fmt.Sprintf("%s/%s/events", libraryDocumentsPath, libraryDocumentId)
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var response LibraryDocumentEvents
+	if _, err := s.client.Do(ctx, req, &response); err != nil {
+		return nil, err
+	}
+
+	return response.Events, nil
+}
+
+// GetFormFields retrieves the form fields of a library document
+// ref: https://secure.na1.adobesign.com/public/docs/restapi/v6#!/libraryDocuments/getFormFields
+func (s *LibraryDocumentService) GetFormFields(ctx context.Context, libraryDocumentId string) ([]FormField, error) {
+	u := fmt.Sprintf("%s/%s/formFields", libraryDocumentsPath, libraryDocumentId)
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var response FormFieldList
+	if _, err := s.client.Do(ctx, req, &response); err != nil {
+		return nil, err
+	}
+
+	return response.Fields, nil
+}
+
+// UpdateFormFields replaces the form fields of a library document in the AUTHORING state
+// ref: https://secure.na1.adobesign.com/public/docs/restapi/v6#!/libraryDocuments/updateFormFields
+func (s *LibraryDocumentService) UpdateFormFields(ctx context.Context, libraryDocumentId string, fields []FormField) ([]FormField, error) {
+	u := fmt.Sprintf("%s/%s/formFields", libraryDocumentsPath, libraryDocumentId)
+
+	req, err := s.client.NewRequest("PUT", u, FormFieldList{Fields: fields})
+	if err != nil {
+		return nil, err
+	}
+
+	var response FormFieldList
+	if _, err := s.client.Do(ctx, req, &response); err != nil {
+		return nil, err
+	}
+
+	return response.Fields, nil
+}
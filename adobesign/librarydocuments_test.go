@@ -0,0 +1,63 @@
+package adobesign
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"testing"
+)
+
+func TestLibraryDocumentDownloads(t *testing.T) {
+	tests := []struct {
+		name     string
+		path     string
+		download func(ctx context.Context, s *LibraryDocumentService) (io.ReadCloser, error)
+	}{
+		{
+			name: "document",
+			path: "/libraryDocuments/l1/documents/d1",
+			download: func(ctx context.Context, s *LibraryDocumentService) (io.ReadCloser, error) {
+				return s.GetDocument(ctx, "l1", "d1")
+			},
+		},
+		{
+			name: "combined document",
+			path: "/libraryDocuments/l1/combinedDocument",
+			download: func(ctx context.Context, s *LibraryDocumentService) (io.ReadCloser, error) {
+				return s.GetCombinedDocument(ctx, "l1")
+			},
+		},
+		{
+			name: "audit trail",
+			path: "/libraryDocuments/l1/auditTrail",
+			download: func(ctx context.Context, s *LibraryDocumentService) (io.ReadCloser, error) {
+				return s.GetAuditTrail(ctx, "l1")
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client, mux := setup(t)
+			mux.HandleFunc(tt.path, func(w http.ResponseWriter, r *http.Request) {
+				fmt.Fprint(w, tt.name)
+			})
+
+			body, err := tt.download(context.Background(), client.LibraryDocumentService)
+			if err != nil {
+				t.Fatalf("download error = %v", err)
+			}
+			defer body.Close()
+
+			got, err := ioutil.ReadAll(body)
+			if err != nil {
+				t.Fatalf("reading download: %v", err)
+			}
+			if string(got) != tt.name {
+				t.Errorf("download = %q, want %q", got, tt.name)
+			}
+		})
+	}
+}
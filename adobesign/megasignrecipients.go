@@ -0,0 +1,251 @@
+package adobesign
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"net/mail"
+	"reflect"
+	"strings"
+)
+
+// megaSignRecipientsColumnEmail is the column of a MegaSign recipients CSV holding the recipient email.
+const megaSignRecipientsColumnEmail = "email"
+
+// megaSignRecipientsFilename is the name under which the recipients CSV is uploaded as a transient document.
+const megaSignRecipientsFilename = "recipients.csv"
+
+// RecipientError describes a problem with a single recipient of a MegaSign.
+type RecipientError struct {
+	// Row The 1-based index of the recipient, the header of a CSV is not counted. Zero for problems with the header
+	Row int
+	// Column The column the problem was found in, empty if it concerns the whole row
+	Column string
+	// Message A description of the problem
+	Message string
+}
+
+func (e RecipientError) Error() string {
+	switch {
+	case e.Row == 0 && e.Column == "":
+		return e.Message
+	case e.Row == 0:
+		return fmt.Sprintf("column %q: %s", e.Column, e.Message)
+	case e.Column == "":
+		return fmt.Sprintf("row %d: %s", e.Row, e.Message)
+	}
+	return fmt.Sprintf("row %d, column %q: %s", e.Row, e.Column, e.Message)
+}
+
+// RecipientValidationError occurs when the recipients of a MegaSign are invalid. Nothing is uploaded nor sent when
+// it is returned.
+type RecipientValidationError struct {
+	Errors []RecipientError
+}
+
+func (e *RecipientValidationError) Error() string {
+	messages := make([]string, len(e.Errors))
+	for i, err := range e.Errors {
+		messages[i] = err.Error()
+	}
+	return fmt.Sprintf("invalid MegaSign recipients: %s", strings.Join(messages, "; "))
+}
+
+// CreateMegaSignFromRecipients creates a MegaSign with one child agreement per element of recipients, which must be
+// a slice of structs or of pointers to structs. The recipient email is read from the field tagged
+// `adobesign:"email"`, the other tagged fields become per-recipient merge fields and are formatted as described in
+// MergeFieldInfos. See CreateMegaSignFromCSV for the validation and upload.
+func (s *MegaSignService) CreateMegaSignFromRecipients(ctx context.Context, request MegaSign, recipients interface{}) (*CreateMegaSignResponse, error) {
+	rv := reflect.ValueOf(recipients)
+	if rv.Kind() != reflect.Slice {
+		return nil, errors.New("adobesign: recipients must be a slice of structs")
+	}
+
+	header := []string{megaSignRecipientsColumnEmail}
+	columns := map[string]int{megaSignRecipientsColumnEmail: 0}
+	var rows []map[string]string
+	for i := 0; i < rv.Len(); i++ {
+		infos, err := MergeFieldInfos(rv.Index(i).Interface())
+		if err != nil {
+			return nil, fmt.Errorf("adobesign: recipient %d: %v", i+1, err)
+		}
+
+		row := make(map[string]string, len(infos))
+		for _, info := range infos {
+			if _, ok := columns[info.FieldName]; !ok {
+				columns[info.FieldName] = len(header)
+				header = append(header, info.FieldName)
+			}
+			row[info.FieldName] = info.DefaultValue
+		}
+		rows = append(rows, row)
+	}
+
+	records := [][]string{header}
+	for _, row := range rows {
+		record := make([]string, len(header))
+		for name, value := range row {
+			record[columns[name]] = value
+		}
+		records = append(records, record)
+	}
+
+	return s.createMegaSignFromRecords(ctx, request, records)
+}
+
+// CreateMegaSignFromCSV creates a MegaSign with one child agreement per row of the recipients CSV. The header row
+// must contain an email column, the other columns are per-recipient merge fields.
+//
+// Before anything is sent every email is validated and, when the MegaSign is created from library documents, every
+// column is checked against the form fields of those documents. All problems are returned together in a
+// *RecipientValidationError. Valid recipients are uploaded as a transient document which is set as
+// request.ChildAgreementsInfo.FileInfo before the MegaSign is created.
+func (s *MegaSignService) CreateMegaSignFromCSV(ctx context.Context, request MegaSign, recipients io.Reader) (*CreateMegaSignResponse, error) {
+	records, err := readRecipientsCSV(recipients)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.createMegaSignFromRecords(ctx, request, records)
+}
+
+// readRecipientsCSV reads the records of a recipients CSV. Rows of the wrong length are kept so validateRecipients
+// can report them.
+func readRecipientsCSV(r io.Reader) ([][]string, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	records, err := reader.ReadAll()
+	if err != nil {
+		return nil, err
+	}
+	if len(records) > 0 && len(records[0]) > 0 {
+		// Spreadsheet exports may start with a UTF-8 byte order mark.
+		records[0][0] = strings.TrimPrefix(records[0][0], "\ufeff")
+	}
+	return records, nil
+}
+
+// createMegaSignFromRecords validates the recipient records, uploads them and creates the MegaSign.
+func (s *MegaSignService) createMegaSignFromRecords(ctx context.Context, request MegaSign, records [][]string) (*CreateMegaSignResponse, error) {
+	fields, err := s.templateFieldNames(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := validateRecipients(records, fields); err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	writer := csv.NewWriter(&buf)
+	if err := writer.WriteAll(records); err != nil {
+		return nil, err
+	}
+
+	document, err := (*TransientDocumentService)(s).UploadTransientDocument(ctx, buf.Bytes(), megaSignRecipientsFilename)
+	if err != nil {
+		return nil, err
+	}
+
+	request.ChildAgreementsInfo.FileInfo = &FileInfo{TransientDocumentId: document.TransientDocumentId}
+	return s.CreateMegaSign(ctx, request)
+}
+
+// templateFieldNames returns the names of the form fields of the library documents the MegaSign is created from, or
+// nil if it is not created from library documents.
+func (s *MegaSignService) templateFieldNames(ctx context.Context, request MegaSign) (map[string]bool, error) {
+	var names map[string]bool
+	for _, file := range request.FileInfos {
+		if file.LibraryDocumentId == "" {
+			continue
+		}
+
+		fields, err := (*LibraryDocumentService)(s).GetFormFields(ctx, file.LibraryDocumentId)
+		if err != nil {
+			return nil, err
+		}
+
+		if names == nil {
+			names = make(map[string]bool)
+		}
+		for _, field := range fields {
+			names[field.Name] = true
+		}
+	}
+
+	return names, nil
+}
+
+// validateRecipients checks the recipient records, the first of which is the header. Column names are matched
+// trimmed and case-insensitively, and the header is rewritten to the matched names so the uploaded CSV uses them. If
+// fields is not nil every column but the email one must be one of its keys.
+func validateRecipients(records [][]string, fields map[string]bool) error {
+	var errs []RecipientError
+
+	if len(records) < 2 {
+		return &RecipientValidationError{Errors: []RecipientError{{Message: "no recipients"}}}
+	}
+
+	var fieldNames map[string]string
+	if fields != nil {
+		fieldNames = make(map[string]string, len(fields))
+		for name := range fields {
+			fieldNames[strings.ToLower(name)] = name
+		}
+	}
+
+	header := records[0]
+	emailColumn := -1
+	seen := make(map[string]bool, len(header))
+	for i, column := range header {
+		column = strings.TrimSpace(column)
+		key := strings.ToLower(column)
+		if seen[key] {
+			errs = append(errs, RecipientError{Column: column, Message: "duplicate column"})
+		}
+		seen[key] = true
+
+		switch {
+		case key == megaSignRecipientsColumnEmail:
+			column = megaSignRecipientsColumnEmail
+			if emailColumn < 0 {
+				emailColumn = i
+			}
+		case fields != nil && !fields[column]:
+			// An exact match wins over form fields whose names only differ in case.
+			if name, ok := fieldNames[key]; ok {
+				column = name
+			} else {
+				errs = append(errs, RecipientError{Column: column, Message: "unknown merge field"})
+			}
+		}
+		header[i] = column
+	}
+	if emailColumn < 0 {
+		errs = append(errs, RecipientError{Column: megaSignRecipientsColumnEmail, Message: "missing email column"})
+		return &RecipientValidationError{Errors: errs}
+	}
+
+	for i, record := range records[1:] {
+		row := i + 1
+		if len(record) != len(header) {
+			errs = append(errs, RecipientError{Row: row, Message: fmt.Sprintf("expected %d columns, got %d", len(header), len(record))})
+			continue
+		}
+
+		email := strings.TrimSpace(record[emailColumn])
+		if email == "" {
+			errs = append(errs, RecipientError{Row: row, Column: header[emailColumn], Message: "missing email"})
+		} else if address, err := mail.ParseAddress(email); err != nil || address.Address != email {
+			errs = append(errs, RecipientError{Row: row, Column: header[emailColumn], Message: fmt.Sprintf("invalid email %q", email)})
+		}
+	}
+
+	if len(errs) > 0 {
+		return &RecipientValidationError{Errors: errs}
+	}
+	return nil
+}
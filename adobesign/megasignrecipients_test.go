@@ -0,0 +1,149 @@
+package adobesign
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestReadRecipientsCSV(t *testing.T) {
+	tests := []struct {
+		name string
+		csv  string
+		want [][]string
+	}{
+		{
+			name: "plain",
+			csv:  "email,company\njane@example.com,Acme\n",
+			want: [][]string{{"email", "company"}, {"jane@example.com", "Acme"}},
+		},
+		{
+			name: "byte order mark",
+			csv:  "\ufeffemail,company\njane@example.com,Acme\n",
+			want: [][]string{{"email", "company"}, {"jane@example.com", "Acme"}},
+		},
+		{
+			name: "short row is kept",
+			csv:  "email,company\njane@example.com\n",
+			want: [][]string{{"email", "company"}, {"jane@example.com"}},
+		},
+		{
+			name: "empty",
+			csv:  "",
+			want: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := readRecipientsCSV(strings.NewReader(tt.csv))
+			if err != nil {
+				t.Fatalf("readRecipientsCSV() error = %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("readRecipientsCSV() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidateRecipientsNormalizesHeader(t *testing.T) {
+	records := [][]string{{" EMAIL ", "company ", "Amount"}, {"jane@example.com", "Acme", "100"}}
+	if err := validateRecipients(records, map[string]bool{"Company": true, "amount": true}); err != nil {
+		t.Fatalf("validateRecipients() error = %v", err)
+	}
+	want := []string{"email", "Company", "amount"}
+	if !reflect.DeepEqual(records[0], want) {
+		t.Errorf("header = %q, want %q", records[0], want)
+	}
+}
+
+func TestValidateRecipients(t *testing.T) {
+	tests := []struct {
+		name    string
+		records [][]string
+		fields  map[string]bool
+		want    []RecipientError
+	}{
+		{
+			name:    "valid",
+			records: [][]string{{"email", "company"}, {"jane@example.com", "Acme"}, {"john@example.com", ""}},
+		},
+		{
+			name:    "email column is case insensitive",
+			records: [][]string{{" Email ", "company"}, {"jane@example.com", "Acme"}},
+		},
+		{
+			name:    "known merge fields",
+			records: [][]string{{"email", "company"}, {"jane@example.com", "Acme"}},
+			fields:  map[string]bool{"company": true},
+		},
+		{
+			name:    "merge fields are case insensitive",
+			records: [][]string{{"email", " COMPANY"}, {"jane@example.com", "Acme"}},
+			fields:  map[string]bool{"Company": true},
+		},
+		{
+			name:    "duplicate columns",
+			records: [][]string{{"email", "company", " Email", "Company "}, {"jane@example.com", "Acme", "john@example.com", "Acme"}},
+			want: []RecipientError{
+				{Column: "Email", Message: "duplicate column"},
+				{Column: "Company", Message: "duplicate column"},
+			},
+		},
+		{
+			name:    "no recipients",
+			records: [][]string{{"email"}},
+			want:    []RecipientError{{Message: "no recipients"}},
+		},
+		{
+			name:    "missing email column",
+			records: [][]string{{"company"}, {"Acme"}},
+			want:    []RecipientError{{Column: "email", Message: "missing email column"}},
+		},
+		{
+			name:    "unknown merge field",
+			records: [][]string{{"email", "company", "amount"}, {"jane@example.com", "Acme", "100"}},
+			fields:  map[string]bool{"company": true},
+			want:    []RecipientError{{Column: "amount", Message: "unknown merge field"}},
+		},
+		{
+			name:    "short and long rows",
+			records: [][]string{{"email", "company"}, {"jane@example.com"}, {"john@example.com", "Acme", "x"}},
+			want: []RecipientError{
+				{Row: 1, Message: "expected 2 columns, got 1"},
+				{Row: 2, Message: "expected 2 columns, got 3"},
+			},
+		},
+		{
+			name:    "missing and invalid emails",
+			records: [][]string{{"email"}, {""}, {"jane"}, {"Jane <jane@example.com>"}, {"john@example.com"}},
+			want: []RecipientError{
+				{Row: 1, Column: "email", Message: "missing email"},
+				{Row: 2, Column: "email", Message: `invalid email "jane"`},
+				{Row: 3, Column: "email", Message: `invalid email "Jane <jane@example.com>"`},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateRecipients(tt.records, tt.fields)
+			if tt.want == nil {
+				if err != nil {
+					t.Fatalf("validateRecipients() error = %v, want nil", err)
+				}
+				return
+			}
+
+			var validationErr *RecipientValidationError
+			if !errors.As(err, &validationErr) {
+				t.Fatalf("validateRecipients() error = %v, want a *RecipientValidationError", err)
+			}
+			if !reflect.DeepEqual(validationErr.Errors, tt.want) {
+				t.Errorf("validateRecipients() errors = %+v, want %+v", validationErr.Errors, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,250 @@
+package adobesign
+
+import (
+	"context"
+	"fmt"
+)
+
+const megaSignsPath = "megaSigns"
+
+// MegaSignService handles operations related to MegaSigns, which send the same agreement to many recipients,
+// creating one child agreement per recipient.
+//
+// ref: https://secure.na1.adobesign.com/public/docs/restapi/v6#!/megaSigns
+type MegaSignService service
+
+type MegaSignChildAgreementInfo struct {
+	// Name The name of the child agreement, defaults to the name of the MegaSign
+	Name string `json:"name,omitempty"`
+	// Ccs A list of one or more CCs that will be copied in the child agreement
+	Ccs []Cc `json:"ccs,omitempty"`
+	// ExternalId An arbitrary value from your system, which can be specified at sending time and then later
+	//returned or queried
+	ExternalId struct {
+		Id string `json:"id,omitempty"`
+	} `json:"externalId,omitempty"`
+	// MergeFieldInfo Default values of the form fields of the child agreement
+	MergeFieldInfo []MergeFieldInfo `json:"mergeFieldInfo,omitempty"`
+	// ParticipantSetsInfo The recipients of the child agreement
+	ParticipantSetsInfo []ParticipantSetInfo `json:"participantSetsInfo"`
+}
+
+type ChildAgreementsInfo struct {
+	// FileInfo A CSV file with one row per child agreement, see CreateMegaSignFromCSV. Either FileInfo or
+	//ChildAgreementsInfoList must be provided
+	FileInfo *FileInfo `json:"fileInfo,omitempty"`
+	// ChildAgreementsInfoList The child agreements to create, one per recipient
+	ChildAgreementsInfoList []MegaSignChildAgreementInfo `json:"childAgreementsInfoList,omitempty"`
+}
+
+// MegaSign defines the request body for creating a MegaSign
+// ref: https://secure.na1.adobesign.com/public/docs/restapi/v6#!/megaSigns/createMegaSign
+type MegaSign struct {
+	// ChildAgreementsInfo The recipients of the child agreements
+	ChildAgreementsInfo ChildAgreementsInfo `json:"childAgreementsInfo"`
+	// FileInfos A list of one or more files used to create the child agreements
+	FileInfos []FileInfo `json:"fileInfos,omitempty"`
+	// Name The name of the MegaSign and, unless overridden, of its child agreements
+	Name string `json:"name,omitempty"`
+	// SignatureType ['ESIGN' or 'WRITTEN']: The type of signature you would like to request, see SignatureType
+	SignatureType string `json:"signatureType,omitempty"`
+	// State ['AUTHORING' or 'DRAFT' or 'IN_PROCESS']: The state in which the MegaSign should land, see MegaSignState
+	State string `json:"state,omitempty"`
+	// Ccs A list of one or more CCs that will be copied in every child agreement
+	Ccs []Cc `json:"ccs,omitempty"`
+	// CreatedDate Date when MegaSign was created. This will be ignored as part of POST or PUT calls
+	CreatedDate string `json:"createdDate,omitempty"`
+	// ExpirationTime Time after which the child agreements expire and are automatically cancelled
+	ExpirationTime string `json:"expirationTime,omitempty"`
+	// FirstReminderDelay Integer which specifies the delay in hours before sending the first reminder
+	FirstReminderDelay int `json:"firstReminderDelay,omitempty"`
+	// GroupId The unique identifier of the group to which the MegaSign belongs
+	GroupId string `json:"groupId,omitempty"`
+	// Id The unique identifier of the MegaSign. This will be ignored as part of POST or PUT calls
+	Id string `json:"id,omitempty"`
+	// Locale The locale associated with the MegaSign
+	Locale string `json:"locale,omitempty"`
+	// MergeFieldInfo Default values of the form fields of every child agreement
+	MergeFieldInfo []MergeFieldInfo `json:"mergeFieldInfo,omitempty"`
+	// Message An optional message to the recipients, describing what is being sent or why their signature is required
+	Message string `json:"message,omitempty"`
+	// ReminderFrequency The frequency at which reminders will be sent to the recipients, see ReminderFrequency
+	ReminderFrequency string `json:"reminderFrequency,omitempty"`
+	// SenderEmail Email of the MegaSign sender. This will be ignored as part of POST or PUT calls
+	SenderEmail string `json:"senderEmail,omitempty"`
+	// Status ['AUTHORING' or 'DRAFT' or 'IN_PROCESS' or 'COMPLETED' or 'CANCELLED']: The current status of the MegaSign
+	Status string `json:"status,omitempty"`
+}
+
+type CreateMegaSignResponse struct {
+	Id string `json:"id"`
+}
+
+type MegaSignInfo struct {
+	// GroupId The unique identifier of the group to which the MegaSign belongs
+	GroupId string `json:"groupId,omitempty"`
+	// Hidden True if the MegaSign is hidden for the calling user
+	Hidden bool `json:"hidden,omitempty"`
+	// Id The unique identifier of the MegaSign
+	Id string `json:"id"`
+	// LastEventDate The date of the last event that occurred on the MegaSign
+	LastEventDate string `json:"lastEventDate,omitempty"`
+	// Name The name of the MegaSign
+	Name string `json:"name"`
+	// Status ['AUTHORING' or 'DRAFT' or 'IN_PROCESS' or 'COMPLETED' or 'CANCELLED']: The current status of the MegaSign
+	Status string `json:"status"`
+}
+
+type MegaSigns struct {
+	// MegaSignList An array of MegaSigns
+	MegaSignList []MegaSignInfo `json:"megaSignList"`
+	// Page Pagination information for navigating through the response
+	Page PageInfo `json:"page"`
+}
+
+type MegaSignCancellationInfo struct {
+	// Comment An optional comment describing why you want to cancel the MegaSign
+	Comment string `json:"comment,omitempty"`
+	// NotifyOthers Whether or not you would like the recipients to be notified that the MegaSign has been cancelled
+	NotifyOthers bool `json:"notifyOthers"`
+}
+
+type MegaSignStateInfo struct {
+	// State The state to which the MegaSign is to be updated, only CANCELLED is supported, see MegaSignState
+	State string `json:"state"`
+	// MegaSignCancellationInfo Cancellation information, required when State is CANCELLED
+	MegaSignCancellationInfo *MegaSignCancellationInfo `json:"megaSignCancellationInfo,omitempty"`
+}
+
+type MegaSignChildAgreements struct {
+	// MegaSignChildAgreementList An array of the child agreements of the MegaSign
+	MegaSignChildAgreementList []AgreementInfo `json:"megaSignChildAgreementList"`
+	// Page Pagination information for navigating through the response
+	Page PageInfo `json:"page"`
+}
+
+// CreateMegaSign creates a new MegaSign
+// ref: https://secure.na1.adobesign.com/public/docs/restapi/v6#!/megaSigns/createMegaSign
+func (s *MegaSignService) CreateMegaSign(ctx context.Context, request MegaSign) (*CreateMegaSignResponse, error) {
+	req, err := s.client.NewRequest("POST", megaSignsPath, request)
+	if err != nil {
+		return nil, err
+	}
+
+	var response *CreateMegaSignResponse
+	if _, err := s.client.Do(ctx, req, &response); err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+// ListMegaSigns retrieves a page of the MegaSigns of the calling user
+// ref: https://secure.na1.adobesign.com/public/docs/restapi/v6#!/megaSigns/getMegaSigns
+func (s *MegaSignService) ListMegaSigns(ctx context.Context, opts *ListOptions) (*MegaSigns, error) {
+	u, err := addOptions(megaSignsPath, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var response *MegaSigns
+	if _, err := s.client.Do(ctx, req, &response); err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+// GetMegaSign retrieves an existing MegaSign
+// ref: https://secure.na1.adobesign.com/public/docs/restapi/v6#!/megaSigns/getMegaSignInfo
+func (s *MegaSignService) GetMegaSign(ctx context.Context, megaSignId string) (*MegaSign, error) {
+	u := fmt.Sprintf("%s/%s", megaSignsPath, megaSignId)
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var response *MegaSign
+	if _, err := s.client.Do(ctx, req, &response); err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+// UpdateMegaSignState cancels an existing MegaSign and its pending child agreements
+// ref: https://secure.na1.adobesign.com/public/docs/restapi/v6#!/megaSigns/updateMegaSignState
+func (s *MegaSignService) UpdateMegaSignState(ctx context.Context, megaSignId string, request MegaSignStateInfo) error {
+	u := fmt.Sprintf("%s/%s/state", megaSignsPath, megaSignId)
+
+	req, err := s.client.NewRequest("PUT", u, request)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.client.Do(ctx, req, nil)
+	return err
+}
+
+// GetMegaSignEvents retrieves the history of events of a MegaSign. MegaSign events share the agreement event model
+// ref: https://secure.na1.adobesign.com/public/docs/restapi/v6#!/megaSigns/getEvents
+func (s *MegaSignService) GetMegaSignEvents(ctx context.Context, megaSignId string) ([]AgreementEvent, error) {
+	u := fmt.Sprintf("%s/%s/events", megaSignsPath, megaSignId)
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var response AgreementEvents
+	if _, err := s.client.Do(ctx, req, &response); err != nil {
+		return nil, err
+	}
+
+	return response.Events, nil
+}
+
+// GetChildAgreementsInfo retrieves the recipient information the child agreements of a MegaSign were created from
+// ref: https://secure.na1.adobesign.com/public/docs/restapi/v6#!/megaSigns/getChildAgreementsInfo
+func (s *MegaSignService) GetChildAgreementsInfo(ctx context.Context, megaSignId string) (*ChildAgreementsInfo, error) {
+	u := fmt.Sprintf("%s/%s/childAgreementsInfo", megaSignsPath, megaSignId)
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var response *ChildAgreementsInfo
+	if _, err := s.client.Do(ctx, req, &response); err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+// ListChildAgreements retrieves a page of the child agreements of a MegaSign
+// ref: https://secure.na1.adobesign.com/public/docs/restapi/v6#!/megaSigns/getMegaSignChildAgreements
+func (s *MegaSignService) ListChildAgreements(ctx context.Context, megaSignId string, opts *ListOptions) (*MegaSignChildAgreements, error) {
+	u, err := addOptions(fmt.Sprintf("%s/%s/agreements", megaSignsPath, megaSignId), opts)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var response *MegaSignChildAgreements
+	if _, err := s.client.Do(ctx, req, &response); err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
@@ -0,0 +1,127 @@
+package adobesign
+
+import (
+	"encoding"
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// defaultMergeTimeFormat is the layout used to format time.Time values that have no format tag option.
+const defaultMergeTimeFormat = "2006-01-02"
+
+type FormFieldMergeInfo struct {
+	// FieldMergeInfos The default values of the form fields
+	FieldMergeInfos []MergeFieldInfo `json:"fieldMergeInfos"`
+}
+
+// MergeFieldInfos converts the struct v, or a pointer to it, into merge field infos. Struct fields are matched to
+// form fields through the `adobesign:"field_name"` tag, untagged fields and fields tagged with "-" are skipped.
+//
+// Values are formatted as follows:
+//   - strings are used as is
+//   - integers, floats and booleans are formatted with the strconv package
+//   - time.Time values are formatted with the layout given in the format option, e.g.
+//     `adobesign:"start_date,format=02/01/2006"`, or as 2006-01-02 without it. The format option must be the last
+//     option of the tag
+//   - types implementing encoding.TextMarshaler or fmt.Stringer are formatted through those interfaces
+//
+// Nil pointers are skipped, as are zero values of fields with the omitempty option.
+func MergeFieldInfos(v interface{}) ([]MergeFieldInfo, error) {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() == reflect.Ptr && !rv.IsNil() {
+		rv = rv.Elem()
+	}
+	if rv.Kind() != reflect.Struct {
+		return nil, errors.New("adobesign: MergeFieldInfos requires a struct or a non-nil pointer to a struct")
+	}
+	rt := rv.Type()
+
+	var infos []MergeFieldInfo
+	for i := 0; i < rt.NumField(); i++ {
+		field := rt.Field(i)
+		name := tagFieldName(field)
+		if name == "" || field.PkgPath != "" {
+			continue
+		}
+		omitEmpty, format := parseMergeTagOptions(field.Tag.Get(structTagName))
+
+		fv := rv.Field(i)
+		if fv.Kind() == reflect.Ptr {
+			if fv.IsNil() {
+				continue
+			}
+			fv = fv.Elem()
+		}
+		if omitEmpty && fv.IsZero() {
+			continue
+		}
+
+		value, err := formatMergeValue(fv, format)
+		if err != nil {
+			return nil, fmt.Errorf("adobesign: merge field %q: %v", name, err)
+		}
+		infos = append(infos, MergeFieldInfo{FieldName: name, DefaultValue: value})
+	}
+
+	return infos, nil
+}
+
+// parseMergeTagOptions returns the omitempty and format options of an adobesign struct tag.
+func parseMergeTagOptions(tag string) (omitEmpty bool, format string) {
+	i := strings.Index(tag, ",")
+	if i < 0 {
+		return false, ""
+	}
+	options := tag[i+1:]
+	for options != "" {
+		if strings.HasPrefix(options, "format=") {
+			// The layout may contain commas, so it takes the rest of the tag.
+			return omitEmpty, strings.TrimPrefix(options, "format=")
+		}
+		option := options
+		if j := strings.Index(options, ","); j >= 0 {
+			option, options = options[:j], options[j+1:]
+		} else {
+			options = ""
+		}
+		if option == "omitempty" {
+			omitEmpty = true
+		}
+	}
+	return omitEmpty, ""
+}
+
+// formatMergeValue formats v as the default value of a form field.
+func formatMergeValue(v reflect.Value, format string) (string, error) {
+	switch value := v.Interface().(type) {
+	case time.Time:
+		if format == "" {
+			format = defaultMergeTimeFormat
+		}
+		return value.Format(format), nil
+	case encoding.TextMarshaler:
+		text, err := value.MarshalText()
+		return string(text), err
+	case fmt.Stringer:
+		return value.String(), nil
+	}
+
+	switch v.Kind() {
+	case reflect.String:
+		return v.String(), nil
+	case reflect.Bool:
+		return strconv.FormatBool(v.Bool()), nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(v.Int(), 10), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return strconv.FormatUint(v.Uint(), 10), nil
+	case reflect.Float32, reflect.Float64:
+		return strconv.FormatFloat(v.Float(), 'f', -1, v.Type().Bits()), nil
+	}
+
+	return "", fmt.Errorf("unsupported type %s", v.Type())
+}
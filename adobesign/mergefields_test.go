@@ -0,0 +1,176 @@
+package adobesign
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+type mergeFieldStatus int
+
+func (s mergeFieldStatus) String() string {
+	if s == 1 {
+		return "active"
+	}
+	return "inactive"
+}
+
+func TestMergeFieldInfos(t *testing.T) {
+	start := time.Date(2021, 6, 15, 9, 31, 24, 0, time.UTC)
+	name := "Jane"
+	var noName *string
+
+	tests := []struct {
+		name    string
+		v       interface{}
+		want    []MergeFieldInfo
+		wantErr bool
+	}{
+		{
+			name: "scalar types",
+			v: struct {
+				Name   string  `adobesign:"name"`
+				Amount int     `adobesign:"amount"`
+				Count  uint8   `adobesign:"count"`
+				Rate   float64 `adobesign:"rate"`
+				Agree  bool    `adobesign:"agree"`
+			}{"Jane", -42, 3, 0.25, true},
+			want: []MergeFieldInfo{
+				{FieldName: "name", DefaultValue: "Jane"},
+				{FieldName: "amount", DefaultValue: "-42"},
+				{FieldName: "count", DefaultValue: "3"},
+				{FieldName: "rate", DefaultValue: "0.25"},
+				{FieldName: "agree", DefaultValue: "true"},
+			},
+		},
+		{
+			name: "pointer to struct",
+			v: &struct {
+				Name string `adobesign:"name"`
+			}{"Jane"},
+			want: []MergeFieldInfo{{FieldName: "name", DefaultValue: "Jane"}},
+		},
+		{
+			name: "default time layout",
+			v: struct {
+				Start time.Time `adobesign:"start_date"`
+			}{start},
+			want: []MergeFieldInfo{{FieldName: "start_date", DefaultValue: "2021-06-15"}},
+		},
+		{
+			name: "time layout",
+			v: struct {
+				Start time.Time `adobesign:"start_date,format=02/01/2006"`
+			}{start},
+			want: []MergeFieldInfo{{FieldName: "start_date", DefaultValue: "15/06/2021"}},
+		},
+		{
+			name: "time layout with commas",
+			v: struct {
+				Start time.Time `adobesign:"start_date,omitempty,format=Jan 2, 2006, 15:04"`
+			}{start},
+			want: []MergeFieldInfo{{FieldName: "start_date", DefaultValue: "Jun 15, 2021, 09:31"}},
+		},
+		{
+			name: "omitempty zero time",
+			v: struct {
+				Start time.Time `adobesign:"start_date,omitempty,format=02/01/2006"`
+				End   time.Time `adobesign:"end_date,omitempty"`
+			}{},
+			want: nil,
+		},
+		{
+			name: "zero time without omitempty",
+			v: struct {
+				Start time.Time `adobesign:"start_date"`
+			}{},
+			want: []MergeFieldInfo{{FieldName: "start_date", DefaultValue: "0001-01-01"}},
+		},
+		{
+			name: "omitempty zero values",
+			v: struct {
+				Name   string `adobesign:"name,omitempty"`
+				Amount int    `adobesign:"amount,omitempty"`
+				Agree  bool   `adobesign:"agree"`
+			}{},
+			want: []MergeFieldInfo{{FieldName: "agree", DefaultValue: "false"}},
+		},
+		{
+			name: "pointers",
+			v: struct {
+				Name     *string `adobesign:"name"`
+				NickName *string `adobesign:"nick_name"`
+			}{&name, noName},
+			want: []MergeFieldInfo{{FieldName: "name", DefaultValue: "Jane"}},
+		},
+		{
+			name: "stringer",
+			v: struct {
+				Status mergeFieldStatus `adobesign:"status"`
+			}{1},
+			want: []MergeFieldInfo{{FieldName: "status", DefaultValue: "active"}},
+		},
+		{
+			name: "skipped fields",
+			v: struct {
+				Skipped    string `adobesign:"-"`
+				Untagged   string
+				unexported string `adobesign:"name"`
+			}{"x", "x", "x"},
+			want: nil,
+		},
+		{
+			name: "unsupported type",
+			v: struct {
+				Tags []string `adobesign:"tags"`
+			}{[]string{"a"}},
+			wantErr: true,
+		},
+		{
+			name:    "not a struct",
+			v:       "Jane",
+			wantErr: true,
+		},
+		{
+			name:    "nil pointer",
+			v:       (*struct{})(nil),
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := MergeFieldInfos(tt.v)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("MergeFieldInfos() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("MergeFieldInfos() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseMergeTagOptions(t *testing.T) {
+	tests := []struct {
+		tag           string
+		wantOmitEmpty bool
+		wantFormat    string
+	}{
+		{tag: "name"},
+		{tag: "name,omitempty", wantOmitEmpty: true},
+		{tag: "name,format=2006-01-02", wantFormat: "2006-01-02"},
+		{tag: "name,omitempty,format=Jan 2, 2006", wantOmitEmpty: true, wantFormat: "Jan 2, 2006"},
+		{tag: "name,format=Jan 2, 2006,omitempty", wantFormat: "Jan 2, 2006,omitempty"},
+		{tag: "name,unknown,omitempty", wantOmitEmpty: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.tag, func(t *testing.T) {
+			omitEmpty, format := parseMergeTagOptions(tt.tag)
+			if omitEmpty != tt.wantOmitEmpty || format != tt.wantFormat {
+				t.Errorf("parseMergeTagOptions(%q) = %v, %q, want %v, %q", tt.tag, omitEmpty, format, tt.wantOmitEmpty, tt.wantFormat)
+			}
+		})
+	}
+}
@@ -0,0 +1,271 @@
+package adobesign
+
+import (
+	"context"
+	"time"
+)
+
+const searchPath = "search"
+
+// SearchService handles operations related to searching agreements.
+//
+// ref: https://secure.na1.adobesign.com/public/docs/restapi/v6#!/search
+type SearchService service
+
+// DateRange bounds a date filter of a search, zero values leave the range open.
+type DateRange struct {
+	// Gt Only match dates after this one
+	Gt string `json:"gt,omitempty"`
+	// Lt Only match dates before this one
+	Lt string `json:"lt,omitempty"`
+}
+
+type DateRangeFilter struct {
+	Range DateRange `json:"range"`
+}
+
+type AgreementAssetsCriteria struct {
+	// Query Text to search for in the QueryableFields
+	Query string `json:"query,omitempty"`
+	// QueryableFields The fields Query is matched against, all of them if empty, see SearchQueryableField
+	QueryableFields []string `json:"queryableFields,omitempty"`
+	// ParticipantEmail Only match agreements with one of these participants
+	ParticipantEmail []string `json:"participantEmail,omitempty"`
+	// Status Only match agreements in one of these statuses, see AgreementStatus
+	Status []string `json:"status,omitempty"`
+	// Role Only match agreements in which the calling user has one of these roles, see ParticipantRole
+	Role []string `json:"role,omitempty"`
+	// CreatedDate Only match agreements created within this range
+	CreatedDate *DateRangeFilter `json:"createdDate,omitempty"`
+	// ModifiedDate Only match agreements modified within this range
+	ModifiedDate *DateRangeFilter `json:"modifiedDate,omitempty"`
+	// Facets The fields for which the number of matches per value is returned, e.g. status or role
+	Facets []string `json:"facets,omitempty"`
+	// PageSize The number of results per page
+	PageSize int `json:"pageSize,omitempty"`
+	// StartIndex The index of the first result to return, taken from SearchPageInfo.NextIndex of the previous page
+	StartIndex int `json:"startIndex,omitempty"`
+}
+
+// SearchRequest defines the request body of a search, see AgreementSearch to build it
+// ref: https://secure.na1.adobesign.com/public/docs/restapi/v6#!/search/createSearch
+type SearchRequest struct {
+	// Scope The resources to search, see SearchScope
+	Scope []string `json:"scope"`
+	// AgreementAssetsCriteria The criteria the agreements must match
+	AgreementAssetsCriteria AgreementAssetsCriteria `json:"agreementAssetsCriteria"`
+}
+
+type AgreementAssetsResult struct {
+	// CreatedDate Date when the agreement was created
+	CreatedDate string `json:"createdDate,omitempty"`
+	// GroupId The unique identifier of the group to which the agreement belongs
+	GroupId string `json:"groupId,omitempty"`
+	// Hidden True if the agreement is hidden for the calling user
+	Hidden bool `json:"hidden,omitempty"`
+	// Id The unique identifier of the agreement
+	Id string `json:"id"`
+	// ModifiedDate Date when the agreement was last modified
+	ModifiedDate string `json:"modifiedDate,omitempty"`
+	// Name of the agreement
+	Name string `json:"name"`
+	// ParentId The unique identifier of the widget or MegaSign the agreement was created from
+	ParentId string `json:"parentId,omitempty"`
+	// ParticipantList The participants of the agreement
+	ParticipantList []DisplayUserInfo `json:"participantList,omitempty"`
+	// Role The role of the calling user in the agreement
+	Role string `json:"role,omitempty"`
+	// Status The current status of the agreement, see AgreementStatus
+	Status string `json:"status"`
+	// Type ['AGREEMENT' or 'MEGASIGN_CHILD' or 'WIDGET_INSTANCE']: Type of agreement
+	Type string `json:"type,omitempty"`
+}
+
+// AgreementInfo returns the agreement summary of the result, as returned by the endpoints that list agreements.
+func (r AgreementAssetsResult) AgreementInfo() AgreementInfo {
+	info := AgreementInfo{
+		DisplayDate: r.ModifiedDate,
+		GroupId:     r.GroupId,
+		Hidden:      r.Hidden,
+		Id:          r.Id,
+		Name:        r.Name,
+		ParentId:    r.ParentId,
+		Status:      r.Status,
+		Type:        r.Type,
+	}
+	if len(r.ParticipantList) > 0 {
+		info.DisplayParticipantSetInfos = []DisplayParticipantSetInfo{{DisplayUserSetMemberInfos: r.ParticipantList}}
+	}
+	return info
+}
+
+type SearchFacetValue struct {
+	// Value of the facet field
+	Value string `json:"value"`
+	// Count The number of matches with this value
+	Count int `json:"count"`
+}
+
+type SearchFacet struct {
+	// Name of the facet field
+	Name string `json:"name"`
+	// Values The number of matches per value
+	Values []SearchFacetValue `json:"values"`
+}
+
+type SearchPageInfo struct {
+	// NextIndex The start index of the next page, 0 if there are no more results
+	NextIndex int `json:"nextIndex,omitempty"`
+	// TotalHits The total number of matches
+	TotalHits int `json:"totalHits"`
+}
+
+type AgreementAssetsResults struct {
+	// AgreementAssetsResultList The agreements of the current page
+	AgreementAssetsResultList []AgreementAssetsResult `json:"agreementAssetsResultList"`
+	// Facets The number of matches per value of the requested facets
+	Facets []SearchFacet `json:"facets,omitempty"`
+	// SearchPageInfo Pagination information for navigating through the results
+	SearchPageInfo SearchPageInfo `json:"searchPageInfo"`
+}
+
+// AgreementInfos returns the agreement summaries of the results of the current page.
+func (r AgreementAssetsResults) AgreementInfos() []AgreementInfo {
+	infos := make([]AgreementInfo, len(r.AgreementAssetsResultList))
+	for i, result := range r.AgreementAssetsResultList {
+		infos[i] = result.AgreementInfo()
+	}
+	return infos
+}
+
+type SearchResponse struct {
+	AgreementAssetsResults AgreementAssetsResults `json:"agreementAssetsResults"`
+}
+
+// AgreementSearch builds the SearchRequest of an agreement search.
+type AgreementSearch struct {
+	criteria AgreementAssetsCriteria
+}
+
+// NewAgreementSearch returns an AgreementSearch without filters.
+func NewAgreementSearch() *AgreementSearch {
+	return &AgreementSearch{}
+}
+
+// Text matches agreements containing text in one of fields, see SearchQueryableField, or in any field if none is given.
+func (b *AgreementSearch) Text(text string, fields ...string) *AgreementSearch {
+	b.criteria.Query = text
+	b.criteria.QueryableFields = fields
+	return b
+}
+
+// Participant matches agreements with one of the given participants.
+func (b *AgreementSearch) Participant(emails ...string) *AgreementSearch {
+	b.criteria.ParticipantEmail = append(b.criteria.ParticipantEmail, emails...)
+	return b
+}
+
+// CreatedBetween matches agreements created between from and to, a zero time leaves that end of the range open.
+func (b *AgreementSearch) CreatedBetween(from, to time.Time) *AgreementSearch {
+	b.criteria.CreatedDate = newDateRangeFilter(from, to)
+	return b
+}
+
+// ModifiedBetween matches agreements modified between from and to, a zero time leaves that end of the range open.
+func (b *AgreementSearch) ModifiedBetween(from, to time.Time) *AgreementSearch {
+	b.criteria.ModifiedDate = newDateRangeFilter(from, to)
+	return b
+}
+
+// Status matches agreements in one of the given statuses, see AgreementStatus.
+func (b *AgreementSearch) Status(statuses ...string) *AgreementSearch {
+	b.criteria.Status = append(b.criteria.Status, statuses...)
+	return b
+}
+
+// Role matches agreements in which the calling user has one of the given roles, see ParticipantRole.
+func (b *AgreementSearch) Role(roles ...string) *AgreementSearch {
+	b.criteria.Role = append(b.criteria.Role, roles...)
+	return b
+}
+
+// Facets requests the number of matches per value of the given fields.
+func (b *AgreementSearch) Facets(fields ...string) *AgreementSearch {
+	b.criteria.Facets = append(b.criteria.Facets, fields...)
+	return b
+}
+
+// PageSize sets the number of results per page.
+func (b *AgreementSearch) PageSize(size int) *AgreementSearch {
+	b.criteria.PageSize = size
+	return b
+}
+
+// StartIndex sets the index of the first result, taken from SearchPageInfo.NextIndex of the previous page.
+func (b *AgreementSearch) StartIndex(index int) *AgreementSearch {
+	b.criteria.StartIndex = index
+	return b
+}
+
+// Request returns the SearchRequest built so far.
+func (b *AgreementSearch) Request() SearchRequest {
+	return SearchRequest{
+		Scope:                   []string{SearchScope.AgreementAssets},
+		AgreementAssetsCriteria: b.criteria,
+	}
+}
+
+// newDateRangeFilter returns a filter for the dates between from and to.
+func newDateRangeFilter(from, to time.Time) *DateRangeFilter {
+	filter := &DateRangeFilter{}
+	if !from.IsZero() {
+		filter.Range.Gt = from.Format(time.RFC3339)
+	}
+	if !to.IsZero() {
+		filter.Range.Lt = to.Format(time.RFC3339)
+	}
+	return filter
+}
+
+// Search retrieves a page of the agreements matching request
+// ref: https://secure.na1.adobesign.com/public/docs/restapi/v6#!/search/createSearch
+func (s *SearchService) Search(ctx context.Context, request SearchRequest) (*SearchResponse, error) {
+	req, err := s.client.NewRequest("POST", searchPath, request)
+	if err != nil {
+		return nil, err
+	}
+
+	var response *SearchResponse
+	if _, err := s.client.Do(ctx, req, &response); err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+// SearchAgreements runs search and retrieves the agreements of every page. Use Search to stop early or to read the
+// facets. If a page points back to an index that was already read, the agreements found so far are returned with
+// ErrPaginationStalled.
+func (s *SearchService) SearchAgreements(ctx context.Context, search *AgreementSearch) ([]AgreementInfo, error) {
+	request := search.Request()
+
+	var infos []AgreementInfo
+	for {
+		response, err := s.Search(ctx, request)
+		if err != nil {
+			return nil, err
+		}
+
+		results := response.AgreementAssetsResults
+		infos = append(infos, results.AgreementInfos()...)
+
+		next := results.SearchPageInfo.NextIndex
+		if next == 0 || len(results.AgreementAssetsResultList) == 0 {
+			return infos, nil
+		}
+		if next <= request.AgreementAssetsCriteria.StartIndex {
+			return infos, ErrPaginationStalled
+		}
+		request.AgreementAssetsCriteria.StartIndex = next
+	}
+}
@@ -0,0 +1,82 @@
+package adobesign
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestSearchAgreements(t *testing.T) {
+	tests := []struct {
+		name      string
+		nextIndex map[int]int
+		wantIds   []string
+		wantErr   error
+	}{
+		{
+			name:      "single page",
+			nextIndex: map[int]int{0: 0},
+			wantIds:   []string{"agreement-0"},
+		},
+		{
+			name:      "several pages",
+			nextIndex: map[int]int{0: 1, 1: 2, 2: 0},
+			wantIds:   []string{"agreement-0", "agreement-1", "agreement-2"},
+		},
+		{
+			name:      "next index repeated",
+			nextIndex: map[int]int{0: 1, 1: 1},
+			wantIds:   []string{"agreement-0", "agreement-1"},
+			wantErr:   ErrPaginationStalled,
+		},
+		{
+			name:      "next index going back",
+			nextIndex: map[int]int{0: 2, 2: 1},
+			wantIds:   []string{"agreement-0", "agreement-2"},
+			wantErr:   ErrPaginationStalled,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client, mux := setup(t)
+
+			requests := 0
+			mux.HandleFunc("/search", func(w http.ResponseWriter, r *http.Request) {
+				if requests++; requests > 10 {
+					t.Error("too many search requests")
+					http.Error(w, "too many requests", http.StatusBadRequest)
+					return
+				}
+
+				var request SearchRequest
+				if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+					t.Errorf("decoding search request: %v", err)
+					return
+				}
+				start := request.AgreementAssetsCriteria.StartIndex
+
+				var response SearchResponse
+				response.AgreementAssetsResults.AgreementAssetsResultList = []AgreementAssetsResult{{Id: fmt.Sprintf("agreement-%d", start)}}
+				response.AgreementAssetsResults.SearchPageInfo.NextIndex = tt.nextIndex[start]
+				_ = json.NewEncoder(w).Encode(response)
+			})
+
+			infos, err := client.SearchService.SearchAgreements(context.Background(), NewAgreementSearch())
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("SearchAgreements() error = %v, want %v", err, tt.wantErr)
+			}
+
+			var ids []string
+			for _, info := range infos {
+				ids = append(ids, info.Id)
+			}
+			if fmt.Sprint(ids) != fmt.Sprint(tt.wantIds) {
+				t.Errorf("SearchAgreements() ids = %v, want %v", ids, tt.wantIds)
+			}
+		})
+	}
+}
@@ -0,0 +1,228 @@
+package adobesign
+
+import (
+	"context"
+	"fmt"
+)
+
+const usersPath = "users"
+
+// currentUserId is the user id Adobe Sign resolves to the user the API is called for.
+const currentUserId = "me"
+
+// UserService handles operations related to the users of an account.
+//
+// ref: https://secure.na1.adobesign.com/public/docs/restapi/v6#!/users
+type UserService service
+
+// User defines the request body for creating and updating a user
+// ref: https://secure.na1.adobesign.com/public/docs/restapi/v6#!/users/createUser
+type User struct {
+	// Email address of the user
+	Email string `json:"email"`
+	// AccountId The unique identifier of the account the user belongs to. This will be ignored as part of POST or PUT
+	//calls
+	AccountId string `json:"accountId,omitempty"`
+	// AccountType ['FREE' or 'PRO' or 'TEAM' or 'TEAM_TRIAL' or 'ENTERPRISE' or 'ENTERPRISE_TRIAL' or 'GLOBAL' or
+	//'GLOBAL_TRIAL']: Type of account to which the user belongs. This will be ignored as part of POST or PUT calls
+	AccountType string `json:"accountType,omitempty"`
+	// Company The name of company of the user
+	Company string `json:"company,omitempty"`
+	// CreatedDate Date when the user was created. This will be ignored as part of POST or PUT calls
+	CreatedDate string `json:"createdDate,omitempty"`
+	// FirstName The first name of the user
+	FirstName string `json:"firstName,omitempty"`
+	// Id The unique identifier of the user. This will be ignored as part of POST or PUT calls
+	Id string `json:"id,omitempty"`
+	// Initials The initials of the user
+	Initials string `json:"initials,omitempty"`
+	// IsAccountAdmin True if the user is account admin. Set it with Bool, Bool(false) revokes the admin rights
+	IsAccountAdmin *bool `json:"isAccountAdmin,omitempty"`
+	// LastName The last name of the user
+	LastName string `json:"lastName,omitempty"`
+	// Locale The UI locale of the user
+	Locale string `json:"locale,omitempty"`
+	// Phone The phone number of the user
+	Phone string `json:"phone,omitempty"`
+	// PrimaryGroupId The unique identifier of the primary group of the user. This will be ignored as part of PUT calls
+	PrimaryGroupId string `json:"primaryGroupId,omitempty"`
+	// Status ['ACTIVE' or 'INACTIVE' or 'CREATED' or 'PENDING' or 'UNVERIFIED']: Status of the user. This will be
+	//ignored as part of POST or PUT calls
+	Status string `json:"status,omitempty"`
+	// Title The job title of the user
+	Title string `json:"title,omitempty"`
+}
+
+type UserInfo struct {
+	// AccountId The unique identifier of the account the user belongs to
+	AccountId string `json:"accountId,omitempty"`
+	// Company The name of company of the user
+	Company string `json:"company,omitempty"`
+	// Email address of the user
+	Email string `json:"email"`
+	// FirstName The first name of the user
+	FirstName string `json:"firstName,omitempty"`
+	// Id The unique identifier of the user
+	Id string `json:"id"`
+	// IsAccountAdmin True if the user is account admin
+	IsAccountAdmin bool `json:"isAccountAdmin"`
+	// LastName The last name of the user
+	LastName string `json:"lastName,omitempty"`
+}
+
+type UserInfoList struct {
+	// UserInfoList An array of users
+	UserInfoList []UserInfo `json:"userInfoList"`
+	// Page Pagination information for navigating through the response
+	Page PageInfo `json:"page"`
+}
+
+type CreateUserResponse struct {
+	Id string `json:"id"`
+}
+
+type UserStateInfo struct {
+	// State ['ACTIVE' or 'INACTIVE']: The state to which the user is to be updated, see UserState
+	State string `json:"state"`
+	// Comment Optional comment describing the state change
+	Comment string `json:"comment,omitempty"`
+}
+
+type UserGroupInfo struct {
+	// CreatedDate Date when the group was created
+	CreatedDate string `json:"createdDate,omitempty"`
+	// Id The unique identifier of the group
+	Id string `json:"id"`
+	// IsGroupAdmin True if the user is a group admin of the group
+	IsGroupAdmin bool `json:"isGroupAdmin"`
+	// IsPrimaryGroup True if the group is the primary group of the user
+	IsPrimaryGroup bool `json:"isPrimaryGroup"`
+	// Name of the group
+	Name string `json:"name"`
+	// Status ['ACTIVE' or 'INACTIVE']: The status of the user in the group
+	Status string `json:"status,omitempty"`
+}
+
+type UserGroupsInfo struct {
+	// GroupInfoList The groups the user belongs to
+	GroupInfoList []UserGroupInfo `json:"groupInfoList"`
+}
+
+// ListUsers retrieves a page of the users of the account
+// ref: https://secure.na1.adobesign.com/public/docs/restapi/v6#!/users/getUsers
+func (s *UserService) ListUsers(ctx context.Context, opts *ListOptions) (*UserInfoList, error) {
+	u, err := addOptions(usersPath, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var response *UserInfoList
+	if _, err := s.client.Do(ctx, req, &response); err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+// CreateUser creates a new user in the account
+// ref: https://secure.na1.adobesign.com/public/docs/restapi/v6#!/users/createUser
+func (s *UserService) CreateUser(ctx context.Context, request User) (*CreateUserResponse, error) {
+	req, err := s.client.NewRequest("POST", usersPath, request)
+	if err != nil {
+		return nil, err
+	}
+
+	var response *CreateUserResponse
+	if _, err := s.client.Do(ctx, req, &response); err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+// GetUser retrieves an existing user
+// ref: https://secure.na1.adobesign.com/public/docs/restapi/v6#!/users/getUserDetail
+func (s *UserService) GetUser(ctx context.Context, userId string) (*User, error) {
+	response, _, err := s.GetUserWithETag(ctx, userId)
+	return response, err
+}
+
+// GetCurrentUser retrieves the user the API is called for, which is the impersonated user if any
+// ref: https://secure.na1.adobesign.com/public/docs/restapi/v6#!/users/getUserDetail
+func (s *UserService) GetCurrentUser(ctx context.Context) (*User, error) {
+	return s.GetUser(ctx, currentUserId)
+}
+
+// GetUserWithETag retrieves an existing user together with its ETag, which must be provided when updating the user
+// ref: https://secure.na1.adobesign.com/public/docs/restapi/v6#!/users/getUserDetail
+func (s *UserService) GetUserWithETag(ctx context.Context, userId string) (*User, string, error) {
+	u := fmt.Sprintf("%s/%s", usersPath, userId)
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, "", err
+	}
+
+	var response *User
+	resp, err := s.client.Do(ctx, req, &response)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return response, resp.Header.Get(headerETag), nil
+}
+
+// UpdateUser updates an existing user. The etag returned by GetUserWithETag is sent in the If-Match header so the
+// update fails if the user was modified in between.
+// ref: https://secure.na1.adobesign.com/public/docs/restapi/v6#!/users/modifyUser
+func (s *UserService) UpdateUser(ctx context.Context, userId, etag string, request User) error {
+	u := fmt.Sprintf("%s/%s", usersPath, userId)
+
+	req, err := s.client.NewRequest("PUT", u, request)
+	if err != nil {
+		return err
+	}
+	if etag != "" {
+		req.Header.Set(headerIfMatch, etag)
+	}
+
+	_, err = s.client.Do(ctx, req, nil)
+	return err
+}
+
+// UpdateUserState activates or deactivates an existing user
+// ref: https://secure.na1.adobesign.com/public/docs/restapi/v6#!/users/modifyUserState
+func (s *UserService) UpdateUserState(ctx context.Context, userId string, request UserStateInfo) error {
+	u := fmt.Sprintf("%s/%s/state", usersPath, userId)
+
+	req, err := s.client.NewRequest("PUT", u, request)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.client.Do(ctx, req, nil)
+	return err
+}
+
+// GetUserGroups retrieves the groups an existing user belongs to
+// ref: https://secure.na1.adobesign.com/public/docs/restapi/v6#!/users/getGroupsOfUser
+func (s *UserService) GetUserGroups(ctx context.Context, userId string) ([]UserGroupInfo, error) {
+	u := fmt.Sprintf("%s/%s/groups", usersPath, userId)
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var response UserGroupsInfo
+	if _, err := s.client.Do(ctx, req, &response); err != nil {
+		return nil, err
+	}
+
+	return response.GroupInfoList, nil
+}
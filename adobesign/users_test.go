@@ -0,0 +1,51 @@
+package adobesign
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+)
+
+func TestUpdateUserAccountAdmin(t *testing.T) {
+	tests := []struct {
+		name      string
+		admin     *bool
+		wantSent  bool
+		wantAdmin bool
+	}{
+		{name: "unset", admin: nil, wantSent: false},
+		{name: "grant", admin: Bool(true), wantSent: true, wantAdmin: true},
+		{name: "revoke", admin: Bool(false), wantSent: true, wantAdmin: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client, mux := setup(t)
+
+			var body map[string]interface{}
+			mux.HandleFunc("/users/u1", func(w http.ResponseWriter, r *http.Request) {
+				if got := r.Header.Get(headerIfMatch); got != "etag-1" {
+					t.Errorf("%s header = %q, want etag-1", headerIfMatch, got)
+				}
+				if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+					t.Errorf("decoding request: %v", err)
+				}
+				w.WriteHeader(http.StatusNoContent)
+			})
+
+			user := User{Email: "jane@example.com", IsAccountAdmin: tt.admin}
+			if err := client.UserService.UpdateUser(context.Background(), "u1", "etag-1", user); err != nil {
+				t.Fatalf("UpdateUser() error = %v", err)
+			}
+
+			admin, sent := body["isAccountAdmin"]
+			if sent != tt.wantSent {
+				t.Fatalf("isAccountAdmin sent = %v, want %v", sent, tt.wantSent)
+			}
+			if sent && admin != tt.wantAdmin {
+				t.Errorf("isAccountAdmin = %v, want %v", admin, tt.wantAdmin)
+			}
+		})
+	}
+}
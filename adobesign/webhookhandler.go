@@ -0,0 +1,129 @@
+package adobesign
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"io/ioutil"
+	"net/http"
+)
+
+// headerClientId is the header Adobe Sign identifies the application a webhook belongs to with. It must be echoed
+// back for the verification of intent and for every notification.
+const headerClientId = "X-AdobeSign-ClientId"
+
+// defaultWebhookMaxBodyBytes is the default size limit of a notification. Notifications can embed signed documents,
+// so the limit is generous.
+const defaultWebhookMaxBodyBytes = 10 << 20
+
+// WebhookHandlerFunc processes a webhook notification. Returning an error makes Adobe Sign retry the notification.
+type WebhookHandlerFunc func(ctx context.Context, payload WebhookPayload) error
+
+// WebhookHandler is an http.Handler receiving Adobe Sign webhook notifications.
+//
+// It answers the verification of intent requests Adobe Sign sends when a webhook is created or activated, either as
+// a GET or as a POST without a body, and echoes the client id of every accepted request in the X-AdobeSign-ClientId
+// header and in the JSON body as Adobe Sign requires. Requests from client ids that are not allowed are rejected
+// with 403 Forbidden, notifications that are not valid JSON with 400 Bad Request, notifications larger than
+// MaxBodyBytes with 413 Request Entity Too Large and notifications the handler function fails to process with 500
+// Internal Server Error so they are retried. Fields whose type does not match WebhookPayload are left empty rather
+// than failing the notification.
+//
+// ref: https://helpx.adobe.com/sign/using/adobe-sign-webhooks-api.html
+type WebhookHandler struct {
+	// ClientIds The client ids of the applications whose notifications are accepted
+	ClientIds []string
+	// MaxBodyBytes The maximum size of a notification, defaults to 10MB
+	MaxBodyBytes int64
+	// Handle The function called with each notification
+	Handle WebhookHandlerFunc
+}
+
+type webhookClientIdResponse struct {
+	XAdobeSignClientId string `json:"xAdobeSignClientId"`
+}
+
+// NewWebhookHandler returns a WebhookHandler calling handle with the notifications of the given client ids.
+func NewWebhookHandler(handle WebhookHandlerFunc, clientIds ...string) *WebhookHandler {
+	return &WebhookHandler{ClientIds: clientIds, Handle: handle}
+}
+
+func (h *WebhookHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	clientId := r.Header.Get(headerClientId)
+	if !h.allowed(clientId) {
+		http.Error(w, http.StatusText(http.StatusForbidden), http.StatusForbidden)
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		// Verification of intent.
+		h.acknowledge(w, clientId)
+	case http.MethodPost:
+		maxBodyBytes := h.MaxBodyBytes
+		if maxBodyBytes <= 0 {
+			maxBodyBytes = defaultWebhookMaxBodyBytes
+		}
+		// Read one byte past the limit to tell a body of exactly maxBodyBytes from a larger one.
+		body, err := ioutil.ReadAll(io.LimitReader(r.Body, maxBodyBytes+1))
+		if err != nil {
+			http.Error(w, http.StatusText(http.StatusBadRequest), http.StatusBadRequest)
+			return
+		}
+		if int64(len(body)) > maxBodyBytes {
+			http.Error(w, http.StatusText(http.StatusRequestEntityTooLarge), http.StatusRequestEntityTooLarge)
+			return
+		}
+		if len(bytes.TrimSpace(body)) == 0 {
+			// Verification of intent sent as a POST without a notification.
+			h.acknowledge(w, clientId)
+			return
+		}
+
+		// A field whose type does not match WebhookPayload is skipped while the rest of the notification is still
+		// decoded, so it does not make Adobe Sign retry and eventually disable the webhook. Only malformed JSON is
+		// rejected.
+		var payload WebhookPayload
+		if err := json.Unmarshal(body, &payload); err != nil {
+			var typeErr *json.UnmarshalTypeError
+			if !errors.As(err, &typeErr) {
+				http.Error(w, http.StatusText(http.StatusBadRequest), http.StatusBadRequest)
+				return
+			}
+		}
+
+		if h.Handle != nil {
+			if err := h.Handle(r.Context(), payload); err != nil {
+				http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+				return
+			}
+		}
+		h.acknowledge(w, clientId)
+	default:
+		w.Header().Set("Allow", "GET, POST")
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+	}
+}
+
+// allowed reports whether clientId is one of the allowed client ids.
+func (h *WebhookHandler) allowed(clientId string) bool {
+	if clientId == "" {
+		return false
+	}
+	for _, id := range h.ClientIds {
+		if id == clientId {
+			return true
+		}
+	}
+	return false
+}
+
+// acknowledge echoes clientId back to Adobe Sign with a 200 OK.
+func (h *WebhookHandler) acknowledge(w http.ResponseWriter, clientId string) {
+	w.Header().Set(headerClientId, clientId)
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(webhookClientIdResponse{XAdobeSignClientId: clientId})
+}
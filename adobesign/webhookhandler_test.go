@@ -0,0 +1,234 @@
+package adobesign
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+// detailedAgreementPayload is an AGREEMENT_ACTION_COMPLETED notification with all agreement details included, as
+// sent by Adobe Sign.
+const detailedAgreementPayload = `{
+  "webhookId": "CBJCHBCAABAA8xZ5kBQbT1gVmxQ3ayBN1vWqXwCW7m2I",
+  "webhookName": "Agreement completed",
+  "webhookNotificationId": "CBJCHBCAABAAbJp9LtZ4w8QWdbBeAL2KXOyrSbLD2f9Z",
+  "webhookUrlInfo": {"url": "https://example.com/webhook"},
+  "webhookScope": "ACCOUNT",
+  "webhookNotificationApplicableUsers": [
+    {"id": "CBJCHBCAABAAZTDx6ZD3fzV3gPh8B3XS7RbF8Tqn2a5j", "email": "sender@example.com", "role": "SENDER", "payloadApplicable": true}
+  ],
+  "event": "AGREEMENT_ACTION_COMPLETED",
+  "subEvent": "",
+  "eventDate": "2021-06-15T09:31:24Z",
+  "eventResourceType": "agreement",
+  "participantRole": "SIGNER",
+  "actionType": "ESIGNED",
+  "participantUserId": "CBJCHBCAABAAQa3oiHb4SvqWYVlR5LNW8kvuNbYNoZbP",
+  "participantUserEmail": "signer@example.com",
+  "actingUserId": "CBJCHBCAABAAQa3oiHb4SvqWYVlR5LNW8kvuNbYNoZbP",
+  "actingUserEmail": "signer@example.com",
+  "actingUserIpAddress": "203.0.113.7",
+  "initiatingUserId": "CBJCHBCAABAAZTDx6ZD3fzV3gPh8B3XS7RbF8Tqn2a5j",
+  "initiatingUserEmail": "sender@example.com",
+  "agreement": {
+    "id": "CBJCHBCAABAA5Q5mYjcWGD5bJ6wXh1wZvbnL2UzzNE2k",
+    "name": "Service Agreement",
+    "signatureType": "ESIGN",
+    "status": "SIGNED",
+    "ccs": [{"email": "cc@example.com", "label": "Legal", "visiblePages": ["1", "2"]}],
+    "deviceInfo": {
+      "applicationDescription": "Acme Portal",
+      "deviceDescription": "iPad",
+      "location": {"latitude": 47.6062, "longitude": -122.3321},
+      "deviceTime": "2021-06-15T09:31:20Z"
+    },
+    "documentVisibilityEnabled": false,
+    "createdDate": "2021-06-15T09:12:03Z",
+    "expirationTime": "2021-07-15T09:12:03Z",
+    "externalId": {"id": "order-1234"},
+    "postSignOption": {"redirectDelay": 3, "redirectUrl": "https://example.com/thanks"},
+    "firstReminderDelay": 24,
+    "locale": "en_US",
+    "message": "Please sign",
+    "reminderFrequency": "DAILY_UNTIL_SIGNED",
+    "senderEmail": "sender@example.com",
+    "vaultingInfo": {"enabled": false},
+    "workflowId": "",
+    "participantSetsInfo": {
+      "participantSets": [
+        {
+          "memberInfos": [
+            {"id": "CBJCHBCAABAAQa3oiHb4SvqWYVlR5LNW8kvuNbYNoZbP", "email": "signer@example.com", "company": "Acme", "name": "Jane Doe", "privateMessage": "", "status": "COMPLETED"}
+          ],
+          "order": 1,
+          "role": "SIGNER",
+          "status": "COMPLETED",
+          "id": "CBJCHBCAABAAs1Y9q6VtXqgKKBsGcT6pXL3x7nRZCkVw",
+          "name": "",
+          "privateMessage": ""
+        }
+      ]
+    },
+    "documentsInfo": {
+      "documents": [
+        {"id": "3AAABLblqZhB9o3xbbRSz2ABUFzH7RBH1ZA4ZpmRY6cmwlDX0A", "label": "", "numPages": 4, "mimeType": "application/pdf", "name": "agreement.pdf"}
+      ],
+      "supportingDocuments": [
+        {"displayLabel": "ID", "fieldName": "id_upload", "id": "3AAABLblqZhCDbjXNqGz0r5cmdTwbBRL2sHo27oYSwm4ZS1rG", "mimeType": "image/png", "numPages": 1}
+      ]
+    }
+  }
+}`
+
+func TestWebhookHandlerDetailedPayload(t *testing.T) {
+	const clientId = "UB7E5BXCXY"
+
+	var received WebhookPayload
+	handler := NewWebhookHandler(func(ctx context.Context, payload WebhookPayload) error {
+		received = payload
+		return nil
+	}, clientId)
+
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	req, err := http.NewRequest(http.MethodPost, server.URL, strings.NewReader(detailedAgreementPayload))
+	if err != nil {
+		t.Fatal(err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(headerClientId, clientId)
+
+	resp, err := server.Client().Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("status = %d, want %d", resp.StatusCode, http.StatusOK)
+	}
+	if got := resp.Header.Get(headerClientId); got != clientId {
+		t.Errorf("%s header = %q, want %q", headerClientId, got, clientId)
+	}
+	var body webhookClientIdResponse
+	if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+		t.Fatalf("decoding response body: %v", err)
+	}
+	if body.XAdobeSignClientId != clientId {
+		t.Errorf("xAdobeSignClientId = %q, want %q", body.XAdobeSignClientId, clientId)
+	}
+
+	agreement := received.Agreement
+	if agreement.Id != "CBJCHBCAABAA5Q5mYjcWGD5bJ6wXh1wZvbnL2UzzNE2k" {
+		t.Errorf("agreement id = %q", agreement.Id)
+	}
+	if got := agreement.ParticipantSetsInfo.ParticipantSets[0].Order; got != 1 {
+		t.Errorf("participant set order = %d, want 1", got)
+	}
+	if got := agreement.DocumentsInfo.Documents[0].NumPages; got != 4 {
+		t.Errorf("document numPages = %d, want 4", got)
+	}
+	if got := agreement.PostSignOption.RedirectDelay; got != 3 {
+		t.Errorf("redirectDelay = %d, want 3", got)
+	}
+	if got := agreement.DeviceInfo.Location.Latitude; got != 47.6062 {
+		t.Errorf("latitude = %v, want 47.6062", got)
+	}
+}
+
+func TestWebhookHandlerVerificationOfIntent(t *testing.T) {
+	tests := []struct {
+		name     string
+		method   string
+		clientId string
+		want     int
+	}{
+		{name: "allowed client", method: http.MethodGet, clientId: "UB7E5BXCXY", want: http.StatusOK},
+		{name: "unknown client", method: http.MethodGet, clientId: "OTHER", want: http.StatusForbidden},
+		{name: "missing client", method: http.MethodGet, clientId: "", want: http.StatusForbidden},
+		{name: "unsupported method", method: http.MethodPut, clientId: "UB7E5BXCXY", want: http.StatusMethodNotAllowed},
+	}
+
+	handler := NewWebhookHandler(nil, "UB7E5BXCXY")
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(tt.method, "/webhook", nil)
+			if tt.clientId != "" {
+				req.Header.Set(headerClientId, tt.clientId)
+			}
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, req)
+			if rec.Code != tt.want {
+				t.Errorf("status = %d, want %d", rec.Code, tt.want)
+			}
+		})
+	}
+}
+
+func TestWebhookHandlerNotifications(t *testing.T) {
+	const clientId = "UB7E5BXCXY"
+	const maxBodyBytes = 128
+
+	tests := []struct {
+		name       string
+		method     string
+		clientId   string
+		body       string
+		handleErr  error
+		want       int
+		wantHandle bool
+	}{
+		{name: "notification", method: http.MethodPost, clientId: clientId, body: `{"event":"AGREEMENT_CREATED"}`, want: http.StatusOK, wantHandle: true},
+		{name: "verification without body", method: http.MethodPost, clientId: clientId, body: "", want: http.StatusOK},
+		{name: "verification with blank body", method: http.MethodPost, clientId: clientId, body: " \n", want: http.StatusOK},
+		{name: "mismatched field type", method: http.MethodPost, clientId: clientId, body: `{"event":"AGREEMENT_CREATED","agreement":{"vaultingInfo":{"enabled":"yes"}}}`, want: http.StatusOK, wantHandle: true},
+		{name: "malformed body", method: http.MethodPost, clientId: clientId, body: `{"event":`, want: http.StatusBadRequest},
+		{name: "not a JSON object", method: http.MethodPost, clientId: clientId, body: `<xml/>`, want: http.StatusBadRequest},
+		{name: "body too large", method: http.MethodPost, clientId: clientId, body: `{"event":"` + strings.Repeat("x", maxBodyBytes) + `"}`, want: http.StatusRequestEntityTooLarge},
+		{name: "body at the limit", method: http.MethodPost, clientId: clientId, body: `{"event":"` + strings.Repeat("x", maxBodyBytes-12) + `"}`, want: http.StatusOK, wantHandle: true},
+		{name: "handler error", method: http.MethodPost, clientId: clientId, body: `{"event":"AGREEMENT_CREATED"}`, handleErr: errors.New("database unavailable"), want: http.StatusInternalServerError, wantHandle: true},
+		{name: "unknown client", method: http.MethodPost, clientId: "OTHER", body: `{"event":"AGREEMENT_CREATED"}`, want: http.StatusForbidden},
+		{name: "disallowed method", method: http.MethodDelete, clientId: clientId, want: http.StatusMethodNotAllowed},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			handled := false
+			handler := NewWebhookHandler(func(ctx context.Context, payload WebhookPayload) error {
+				handled = true
+				if payload.Event == "" {
+					t.Error("payload event is empty")
+				}
+				return tt.handleErr
+			}, clientId)
+			handler.MaxBodyBytes = maxBodyBytes
+
+			req := httptest.NewRequest(tt.method, "/webhook", strings.NewReader(tt.body))
+			req.Header.Set(headerClientId, tt.clientId)
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, req)
+
+			if rec.Code != tt.want {
+				t.Errorf("status = %d, want %d", rec.Code, tt.want)
+			}
+			if handled != tt.wantHandle {
+				t.Errorf("handler called = %v, want %v", handled, tt.wantHandle)
+			}
+			if tt.want != http.StatusOK {
+				return
+			}
+			if got := rec.Header().Get(headerClientId); got != clientId {
+				t.Errorf("%s header = %q, want %q", headerClientId, got, clientId)
+			}
+			var body webhookClientIdResponse
+			if err := json.NewDecoder(rec.Body).Decode(&body); err != nil || body.XAdobeSignClientId != clientId {
+				t.Errorf("response body = %+v, %v, want xAdobeSignClientId %q", body, err, clientId)
+			}
+		})
+	}
+}
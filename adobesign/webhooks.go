@@ -2,6 +2,7 @@ package adobesign
 
 import (
 	"context"
+	"fmt"
 )
 
 // WebhookService handles operations related to webhooks.
@@ -22,7 +23,7 @@ type WebhookPayload struct {
 		Id                string `json:"id"`
 		Email             string `json:"email"`
 		Role              string `json:"role"`
-		PayloadApplicable string `json:"payloadApplicable"`
+		PayloadApplicable bool   `json:"payloadApplicable"`
 	} `json:"webhookNotificationApplicableUsers"`
 	Event                   string `json:"event"`
 	SubEvent                string `json:"subEvent"`
@@ -53,28 +54,28 @@ type WebhookPayload struct {
 			ApplicationDescription string `json:"applicationDescription"`
 			DeviceDescription      string `json:"deviceDescription"`
 			Location               struct {
-				Latitude  string `json:"latitude"`
-				Longitude string `json:"longitude"`
+				Latitude  float64 `json:"latitude"`
+				Longitude float64 `json:"longitude"`
 			} `json:"location"`
 			DeviceTime string `json:"deviceTime"`
 		} `json:"deviceInfo"`
-		DocumentVisibilityEnabled string `json:"documentVisibilityEnabled"`
+		DocumentVisibilityEnabled bool   `json:"documentVisibilityEnabled"`
 		CreatedDate               string `json:"createdDate"`
 		ExpirationTime            string `json:"expirationTime"`
 		ExternalId                struct {
 			Id string `json:"id"`
 		} `json:"externalId"`
 		PostSignOption struct {
-			RedirectDelay string `json:"redirectDelay"`
+			RedirectDelay int    `json:"redirectDelay"`
 			RedirectUrl   string `json:"redirectUrl"`
 		} `json:"postSignOption"`
-		FirstReminderDelay string `json:"firstReminderDelay"`
+		FirstReminderDelay int    `json:"firstReminderDelay"`
 		Locale             string `json:"locale"`
 		Message            string `json:"message"`
 		ReminderFrequency  string `json:"reminderFrequency"`
 		SenderEmail        string `json:"senderEmail"`
 		VaultingInfo       struct {
-			Enabled string `json:"enabled"`
+			Enabled bool `json:"enabled"`
 		} `json:"vaultingInfo"`
 		WorkflowId          string `json:"workflowId"`
 		ParticipantSetsInfo struct {
@@ -87,7 +88,7 @@ type WebhookPayload struct {
 					PrivateMessage string `json:"privateMessage"`
 					Status         string `json:"status"`
 				} `json:"memberInfos"`
-				Order          string `json:"order"`
+				Order          int    `json:"order"`
 				Role           string `json:"role"`
 				Status         string `json:"status"`
 				Id             string `json:"id"`
@@ -99,7 +100,7 @@ type WebhookPayload struct {
 			Documents []struct {
 				Id       string `json:"id"`
 				Label    string `json:"label"`
-				NumPages string `json:"numPages"`
+				NumPages int    `json:"numPages"`
 				MimeType string `json:"mimeType"`
 				Name     string `json:"name"`
 			} `json:"documents"`
@@ -108,7 +109,7 @@ type WebhookPayload struct {
 				FieldName    string `json:"fieldName"`
 				Id           string `json:"id"`
 				MimeType     string `json:"mimeType"`
-				NumPages     string `json:"numPages"`
+				NumPages     int    `json:"numPages"`
 			} `json:"supportingDocuments"`
 		} `json:"documentsInfo"`
 	} `json:"agreement"`
@@ -147,7 +148,9 @@ type WebhookConditionalParams struct {
 	WebhookWidgetEvents          WebhookWidgetEvents          `json:"webhookWidgetEvents,omitempty"`
 }
 
-type CreateWebhookRequest struct {
+// Webhook defines a webhook subscription, it is used both to create webhooks and to read them back
+// ref: https://secure.na1.echosign.com/public/docs/restapi/v6#!/webhooks/getWebhookInfo
+type Webhook struct {
 	Name                      string         `json:"name,omitempty"`
 	Scope                     string         `json:"scope,omitempty"`
 	State                     string         `json:"state,omitempty"`
@@ -167,6 +170,9 @@ type CreateWebhookRequest struct {
 	WebhookConditionalParams WebhookConditionalParams `json:"webhookConditionalParams,omitempty"`
 }
 
+// CreateWebhookRequest defines the request body for creating a webhook.
+type CreateWebhookRequest = Webhook
+
 type CreateWebhookResponse struct {
 	Id string `json:"id"`
 }
@@ -188,3 +194,169 @@ func (s *WebhookService) CreateWebhook(ctx context.Context, request CreateWebhoo
 
 	return response, nil
 }
+
+type UserWebhook struct {
+	// ApplicationDisplayName Display name of the application through which the webhook is created
+	ApplicationDisplayName string `json:"applicationDisplayName,omitempty"`
+	// ApplicationName Name of the application through which the webhook is created
+	ApplicationName string `json:"applicationName,omitempty"`
+	// Created The date when the webhook was created
+	Created string `json:"created,omitempty"`
+	// Id The unique identifier of the webhook
+	Id string `json:"id"`
+	// LastModified The date when the webhook was last modified
+	LastModified string `json:"lastModified,omitempty"`
+	// Name The name of the webhook
+	Name string `json:"name"`
+	// ResourceId The unique identifier of the resource the webhook is registered on, only for the RESOURCE scope
+	ResourceId string `json:"resourceId,omitempty"`
+	// ResourceType The type of the resource the webhook is registered on, see Resource
+	ResourceType string `json:"resourceType,omitempty"`
+	// Scope The scope of the webhook, see Scope
+	Scope string `json:"scope"`
+	// State The state of the webhook, see WebhookState
+	State string `json:"state"`
+	// Status ['ACTIVE' or 'AUTO_CANCELLED' or 'CANCELLED']: Status of the webhook
+	Status string `json:"status,omitempty"`
+	// WebhookSubscriptionEvents The events the webhook is subscribed to, see WebhookSubscriptionEvent
+	WebhookSubscriptionEvents []string `json:"webhookSubscriptionEvents,omitempty"`
+	// WebhookUrlInfo The url the notifications are sent to
+	WebhookUrlInfo WebhookUrlInfo `json:"webhookUrlInfo"`
+}
+
+type UserWebhooks struct {
+	// UserWebhookList An array of webhooks
+	UserWebhookList []UserWebhook `json:"userWebhookList"`
+	// Page Pagination information for navigating through the response
+	Page PageInfo `json:"page"`
+}
+
+// WebhookListOptions specifies the optional parameters of ListWebhooks.
+type WebhookListOptions struct {
+	ListOptions
+
+	// ShowInActiveWebhooks Also returns the inactive webhooks
+	ShowInActiveWebhooks bool `url:"showInActiveWebhooks,omitempty"`
+	// Scope Only returns the webhooks of this scope, see Scope
+	Scope string `url:"scope,omitempty"`
+	// ResourceType Only returns the webhooks registered on this type of resource, see Resource
+	ResourceType string `url:"resourceType,omitempty"`
+	// State Only returns the webhooks in this state, see WebhookState. Adobe Sign does not support this filter so it
+	//is applied to each returned page, which may therefore hold fewer than PageSize webhooks
+	State string `url:"-"`
+}
+
+type WebhookStateInfo struct {
+	// State The state to which the webhook is to be updated, see WebhookState
+	State string `json:"state"`
+}
+
+// ListWebhooks retrieves a page of the webhooks of the calling user
+// ref: https://secure.na1.echosign.com/public/docs/restapi/v6#!/webhooks/getWebhooks
+func (s *WebhookService) ListWebhooks(ctx context.Context, opts *WebhookListOptions) (*UserWebhooks, error) {
+	if opts != nil && opts.State == WebhookState.Inactive {
+		options := *opts
+		options.ShowInActiveWebhooks = true
+		opts = &options
+	}
+
+	u, err := addOptions(webhooksPath, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var response *UserWebhooks
+	if _, err := s.client.Do(ctx, req, &response); err != nil {
+		return nil, err
+	}
+
+	if response != nil && opts != nil && opts.State != "" {
+		webhooks := response.UserWebhookList[:0]
+		for _, webhook := range response.UserWebhookList {
+			if webhook.State == opts.State {
+				webhooks = append(webhooks, webhook)
+			}
+		}
+		response.UserWebhookList = webhooks
+	}
+
+	return response, nil
+}
+
+// GetWebhook retrieves an existing webhook
+// ref: https://secure.na1.echosign.com/public/docs/restapi/v6#!/webhooks/getWebhookInfo
+func (s *WebhookService) GetWebhook(ctx context.Context, webhookId string) (*Webhook, error) {
+	response, _, err := s.GetWebhookWithETag(ctx, webhookId)
+	return response, err
+}
+
+// GetWebhookWithETag retrieves an existing webhook together with its ETag, which must be provided when updating the
+// webhook
+// ref: https://secure.na1.echosign.com/public/docs/restapi/v6#!/webhooks/getWebhookInfo
+func (s *WebhookService) GetWebhookWithETag(ctx context.Context, webhookId string) (*Webhook, string, error) {
+	u := fmt.Sprintf("%s/%s", webhooksPath, webhookId)
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, "", err
+	}
+
+	var response *Webhook
+	resp, err := s.client.Do(ctx, req, &response)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return response, resp.Header.Get(headerETag), nil
+}
+
+// UpdateWebhook updates an existing webhook. The etag returned by GetWebhookWithETag is sent in the If-Match header
+// so the update fails if the webhook was modified in between.
+// ref: https://secure.na1.echosign.com/public/docs/restapi/v6#!/webhooks/updateWebhook
+func (s *WebhookService) UpdateWebhook(ctx context.Context, webhookId, etag string, request Webhook) error {
+	u := fmt.Sprintf("%s/%s", webhooksPath, webhookId)
+
+	req, err := s.client.NewRequest("PUT", u, request)
+	if err != nil {
+		return err
+	}
+	if etag != "" {
+		req.Header.Set(headerIfMatch, etag)
+	}
+
+	_, err = s.client.Do(ctx, req, nil)
+	return err
+}
+
+// UpdateWebhookState activates or deactivates an existing webhook
+// ref: https://secure.na1.echosign.com/public/docs/restapi/v6#!/webhooks/updateWebhookState
+func (s *WebhookService) UpdateWebhookState(ctx context.Context, webhookId string, state string) error {
+	u := fmt.Sprintf("%s/%s/state", webhooksPath, webhookId)
+
+	req, err := s.client.NewRequest("PUT", u, WebhookStateInfo{State: state})
+	if err != nil {
+		return err
+	}
+
+	_, err = s.client.Do(ctx, req, nil)
+	return err
+}
+
+// DeleteWebhook deletes an existing webhook
+// ref: https://secure.na1.echosign.com/public/docs/restapi/v6#!/webhooks/deleteWebhook
+func (s *WebhookService) DeleteWebhook(ctx context.Context, webhookId string) error {
+	u := fmt.Sprintf("%s/%s", webhooksPath, webhookId)
+
+	req, err := s.client.NewRequest("DELETE", u, nil)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.client.Do(ctx, req, nil)
+	return err
+}
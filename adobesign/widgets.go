@@ -0,0 +1,372 @@
+package adobesign
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+)
+
+const widgetsPath = "widgets"
+
+// WidgetService handles operations related to widgets (web forms).
+//
+// ref: https://secure.na1.adobesign.com/public/docs/restapi/v6#!/widgets
+type WidgetService service
+
+type WidgetParticipantSetInfo struct {
+	// MemberInfos Array of MemberInfo objects. The widget signer, who fills the web form, is not part of it
+	MemberInfos []MemberInfo `json:"memberInfos"`
+	// Role Role assumed by all participants in the set, see ParticipantRole
+	Role string `json:"role"`
+	// Name of the participant set
+	Name string `json:"name,omitempty"`
+	// PrivateMessage Participant set's private message - all participants in the set will receive the same message
+	PrivateMessage string `json:"privateMessage,omitempty"`
+}
+
+type WidgetRedirectInfo struct {
+	// Deframe If the widget is embedded in an iframe, whether the redirect should break out of it
+	Deframe bool `json:"deframe,omitempty"`
+	// Delay The delay (in seconds) before the user is taken to the url
+	Delay int `json:"delay,omitempty"`
+	// Url A publicly accessible url to which the user will be sent
+	Url string `json:"url"`
+}
+
+// Widget defines the request body for creating a widget
+// ref: https://secure.na1.adobesign.com/public/docs/restapi/v6#!/widgets/createWidget
+type Widget struct {
+	// FileInfos A list of one or more files used to create the widget
+	FileInfos []FileInfo `json:"fileInfos,omitempty"`
+	// Name The name of the widget that will be used to identify it, in emails, website and other places
+	Name string `json:"name,omitempty"`
+	// State ['AUTHORING' or 'DRAFT' or 'ACTIVE']: The state in which the widget should land, see WidgetState
+	State string `json:"state,omitempty"`
+	// WidgetParticipantSetInfo Information about the widget signer
+	WidgetParticipantSetInfo WidgetParticipantSetInfo `json:"widgetParticipantSetInfo,omitempty"`
+	// AdditionalParticipantSetsInfo Information about the counter signers of the widget
+	AdditionalParticipantSetsInfo []ParticipantSetInfo `json:"additionalParticipantSetsInfo,omitempty"`
+	// AuthFailureInfo Url and associated properties for the error page the widget signer will be taken after
+	//failing to authenticate
+	AuthFailureInfo *WidgetRedirectInfo `json:"authFailureInfo,omitempty"`
+	// Ccs A list of one or more CCs that will be copied in the widget transaction
+	Ccs []Cc `json:"ccs,omitempty"`
+	// CompletionInfo Url and associated properties for the success page the widget signer will be taken to after
+	//performing desired action on the widget
+	CompletionInfo *WidgetRedirectInfo `json:"completionInfo,omitempty"`
+	// CreatedDate Date when widget was created. This will be ignored as part of POST or PUT calls
+	CreatedDate string `json:"createdDate,omitempty"`
+	// CreatorEmail Email of the widget creator. This will be ignored as part of POST or PUT calls
+	CreatorEmail string `json:"creatorEmail,omitempty"`
+	// GroupId The unique identifier of the group to which the widget belongs
+	GroupId string `json:"groupId,omitempty"`
+	// Id The unique identifier of the widget. This will be ignored as part of POST or PUT calls
+	Id string `json:"id,omitempty"`
+	// Javascript The embedded javascript code of the widget. This will be ignored as part of POST or PUT calls
+	Javascript string `json:"javascript,omitempty"`
+	// LastEventDate The date of the last event that occurred on the widget
+	LastEventDate string `json:"lastEventDate,omitempty"`
+	// Locale The locale associated with this widget
+	Locale string `json:"locale,omitempty"`
+	// SignatureType ['ESIGN' or 'WRITTEN']: The type of signature you would like to request, see SignatureType
+	SignatureType string `json:"signatureType,omitempty"`
+	// Status ['DRAFT' or 'AUTHORING' or 'ACTIVE' or 'INACTIVE' or 'CANCELLED']: The current status of the widget
+	Status string `json:"status,omitempty"`
+	// Url The public url of the widget. This will be ignored as part of POST or PUT calls
+	Url string `json:"url,omitempty"`
+	// VaultingInfo Vaulting properties that allows Adobe Sign to securely store documents with a vault provider
+	VaultingInfo struct {
+		Enabled bool `json:"enabled,omitempty"`
+	} `json:"vaultingInfo,omitempty"`
+}
+
+type CreateWidgetResponse struct {
+	Id string `json:"id"`
+}
+
+type UserWidget struct {
+	// GroupId The unique identifier of the group to which the widget belongs
+	GroupId string `json:"groupId,omitempty"`
+	// Hidden True if the widget is hidden from the calling user
+	Hidden bool `json:"hidden,omitempty"`
+	// Id The unique identifier of the widget
+	Id string `json:"id"`
+	// Javascript The embedded javascript code of the widget
+	Javascript string `json:"javascript"`
+	// ModifiedDate The day on which the widget was last modified
+	ModifiedDate string `json:"modifiedDate"`
+	// Name The name of the widget
+	Name string `json:"name"`
+	// Status ['DRAFT' or 'AUTHORING' or 'ACTIVE' or 'INACTIVE' or 'CANCELLED']: The current status of the widget
+	Status string `json:"status"`
+	// Url The public url of the widget
+	Url string `json:"url"`
+}
+
+type UserWidgets struct {
+	// UserWidgetList An array of widgets
+	UserWidgetList []UserWidget `json:"userWidgetList"`
+	// Page Pagination information for navigating through the response
+	Page PageInfo `json:"page"`
+}
+
+// WidgetListOptions specifies the optional parameters of ListWidgets.
+type WidgetListOptions struct {
+	ListOptions
+
+	// ShowHiddenWidgets Also returns the widgets hidden by the calling user
+	ShowHiddenWidgets bool `url:"showHiddenWidgets,omitempty"`
+}
+
+type WidgetInactiveInfo struct {
+	// Message Display this message to the user when the widget is accessed but is inactive
+	Message string `json:"message"`
+	// RedirectUrl Redirect the user to this url when the widget is accessed but is inactive
+	RedirectUrl string `json:"redirectUrl,omitempty"`
+}
+
+type WidgetStateInfo struct {
+	// State ['ACTIVE' or 'INACTIVE']: The state to which the widget is to be updated, see WidgetState
+	State string `json:"state"`
+	// WidgetInactiveInfo Redirect information shown when the widget is inactive, required when State is INACTIVE
+	WidgetInactiveInfo *WidgetInactiveInfo `json:"widgetInactiveInfo,omitempty"`
+}
+
+type WidgetMembersInfo struct {
+	// AdditionalParticipantSets Information about the counter signers of the widget
+	AdditionalParticipantSets []DetailedParticipantSetInfo `json:"additionalParticipantSets,omitempty"`
+	// CcsInfo Information of CC participants of the widget
+	CcsInfo []CCParticipantInfo `json:"ccsInfo,omitempty"`
+	// SenderInfo Information of the sender of the widget
+	SenderInfo SenderInfo `json:"senderInfo"`
+	// SharesInfo Information of the participants with whom the widget has been shared
+	SharesInfo []ShareParticipantInfo `json:"sharesInfo,omitempty"`
+	// WidgetParticipantSet Information about the widget signer
+	WidgetParticipantSet DetailedParticipantSetInfo `json:"widgetParticipantSet"`
+}
+
+type WidgetViewInfo struct {
+	// Name Name of the requested widget view, see WidgetView
+	Name string `json:"name"`
+	// CommonViewConfiguration Configuration that applies to all the requested views
+	CommonViewConfiguration CommonViewConfiguration `json:"commonViewConfiguration,omitempty"`
+}
+
+type WidgetViews struct {
+	// WidgetViewList List of the requested widget views
+	WidgetViewList []ViewUrl `json:"widgetViewList"`
+}
+
+// CreateWidget creates a new widget
+// ref: https://secure.na1.adobesign.com/public/docs/restapi/v6#!/widgets/createWidget
+func (s *WidgetService) CreateWidget(ctx context.Context, request Widget) (*CreateWidgetResponse, error) {
+	req, err := s.client.NewRequest("POST", widgetsPath, request)
+	if err != nil {
+		return nil, err
+	}
+
+	var response *CreateWidgetResponse
+	if _, err := s.client.Do(ctx, req, &response); err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+// ListWidgets retrieves a page of the widgets of the calling user, including their public url and embed javascript
+// ref: https://secure.na1.adobesign.com/public/docs/restapi/v6#!/widgets/getWidgets
+func (s *WidgetService) ListWidgets(ctx context.Context, opts *WidgetListOptions) (*UserWidgets, error) {
+	u, err := addOptions(widgetsPath, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var response *UserWidgets
+	if _, err := s.client.Do(ctx, req, &response); err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+// GetWidget retrieves an existing widget
+// ref: https://secure.na1.adobesign.com/public/docs/restapi/v6#!/widgets/getWidgetInfo
+func (s *WidgetService) GetWidget(ctx context.Context, widgetId string) (*Widget, error) {
+	response, _, err := s.GetWidgetWithETag(ctx, widgetId)
+	return response, err
+}
+
+// GetWidgetWithETag retrieves an existing widget together with its ETag, which must be provided when updating the
+// widget
+// ref: https://secure.na1.adobesign.com/public/docs/restapi/v6#!/widgets/getWidgetInfo
+func (s *WidgetService) GetWidgetWithETag(ctx context.Context, widgetId string) (*Widget, string, error) {
+	u := fmt.Sprintf("%s/%s", widgetsPath, widgetId)
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, "", err
+	}
+
+	var response *Widget
+	resp, err := s.client.Do(ctx, req, &response)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return response, resp.Header.Get(headerETag), nil
+}
+
+// UpdateWidget updates an existing widget. The etag returned by GetWidgetWithETag is sent in the If-Match header so
+// the update fails if the widget was modified in between.
+// ref: https://secure.na1.adobesign.com/public/docs/restapi/v6#!/widgets/updateWidget
+func (s *WidgetService) UpdateWidget(ctx context.Context, widgetId, etag string, request Widget) error {
+	u := fmt.Sprintf("%s/%s", widgetsPath, widgetId)
+
+	req, err := s.client.NewRequest("PUT", u, request)
+	if err != nil {
+		return err
+	}
+	if etag != "" {
+		req.Header.Set(headerIfMatch, etag)
+	}
+
+	_, err = s.client.Do(ctx, req, nil)
+	return err
+}
+
+// UpdateWidgetState activates or deactivates an existing widget
+// ref: https://secure.na1.adobesign.com/public/docs/restapi/v6#!/widgets/updateWidgetState
+func (s *WidgetService) UpdateWidgetState(ctx context.Context, widgetId string, request WidgetStateInfo) error {
+	u := fmt.Sprintf("%s/%s/state", widgetsPath, widgetId)
+
+	req, err := s.client.NewRequest("PUT", u, request)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.client.Do(ctx, req, nil)
+	return err
+}
+
+// GetWidgetMembers retrieves information of members of an existing widget
+// ref: https://secure.na1.adobesign.com/public/docs/restapi/v6#!/widgets/getWidgetAllMembers
+func (s *WidgetService) GetWidgetMembers(ctx context.Context, widgetId string) (*WidgetMembersInfo, error) {
+	u := fmt.Sprintf("%s/%s/members", widgetsPath, widgetId)
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var response *WidgetMembersInfo
+	if _, err := s.client.Do(ctx, req, &response); err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+// CreateWidgetView returns the urls of the requested pages of an existing widget
+// ref: https://secure.na1.adobesign.com/public/docs/restapi/v6#!/widgets/getWidgetView
+func (s *WidgetService) CreateWidgetView(ctx context.Context, widgetId string, request WidgetViewInfo) ([]ViewUrl, error) {
+	u := fmt.Sprintf("%s/%s/views", widgetsPath, widgetId)
+
+	req, err := s.client.NewRequest("POST", u, request)
+	if err != nil {
+		return nil, err
+	}
+
+	var response WidgetViews
+	if _, err := s.client.Do(ctx, req, &response); err != nil {
+		return nil, err
+	}
+
+	return response.WidgetViewList, nil
+}
+
+type WidgetAgreements struct {
+	// UserAgreementList An array of the agreements created from the widget
+	UserAgreementList []AgreementInfo `json:"userAgreementList"`
+	// Page Pagination information for navigating through the response
+	Page PageInfo `json:"page"`
+}
+
+// ListWidgetAgreements retrieves a page of the agreements created by the submissions of a widget
+// ref: https://secure.na1.adobesign.com/public/docs/restapi/v6#!/widgets/getWidgetAgreements
+func (s *WidgetService) ListWidgetAgreements(ctx context.Context, widgetId string, opts *ListOptions) (*WidgetAgreements, error) {
+	u, err := addOptions(fmt.Sprintf("%s/%s/agreements", widgetsPath, widgetId), opts)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var response *WidgetAgreements
+	if _, err := s.client.Do(ctx, req, &response); err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+// GetFormData retrieves the data entered into the form fields of a widget, one row per submission
+// ref: https://secure.na1.adobesign.com/public/docs/restapi/v6#!/widgets/getWidgetFormData
+func (s *WidgetService) GetFormData(ctx context.Context, widgetId string) ([]FormDataRow, error) {
+	u := fmt.Sprintf("%s/%s/formData", widgetsPath, widgetId)
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var response bytes.Buffer
+	if _, err := s.client.Do(ctx, req, &response); err != nil {
+		return nil, err
+	}
+
+	return parseFormData(&response)
+}
+
+// GetWidgetEvents retrieves the history of events of a widget. Widget events share the agreement event model
+// ref: https://secure.na1.adobesign.com/public/docs/restapi/v6#!/widgets/getWidgetEvents
+func (s *WidgetService) GetWidgetEvents(ctx context.Context, widgetId string) ([]AgreementEvent, error) {
+	u := fmt.Sprintf("%s/%s/events", widgetsPath, widgetId)
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var response AgreementEvents
+	if _, err := s.client.Do(ctx, req, &response); err != nil {
+		return nil, err
+	}
+
+	return response.Events, nil
+}
+
+// GetAuditTrail retrieves the PDF file stream containing the audit trail of a widget
+// ref: https://secure.na1.adobesign.com/public/docs/restapi/v6#!/widgets/getWidgetAuditTrail
+func (s *WidgetService) GetAuditTrail(ctx context.Context, widgetId string) ([]byte, error) {
+	u := fmt.Sprintf("%s/%s/auditTrail", widgetsPath, widgetId)
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var response bytes.Buffer
+	if _, err := s.client.Do(ctx, req, &response); err != nil {
+		return nil, err
+	}
+
+	return response.Bytes(), nil
+}
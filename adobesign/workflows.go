@@ -0,0 +1,425 @@
+package adobesign
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+)
+
+const workflowsPath = "workflows"
+
+// WorkflowService handles operations related to custom workflows.
+//
+// ref: https://secure.na1.adobesign.com/public/docs/restapi/v6#!/workflows
+type WorkflowService service
+
+type UserWorkflow struct {
+	// Created The date on which the workflow was created
+	Created string `json:"created,omitempty"`
+	// Description The description of the workflow
+	Description string `json:"description,omitempty"`
+	// DisplayName The name of the workflow as displayed to the user
+	DisplayName string `json:"displayName"`
+	// Id The unique identifier of the workflow
+	Id string `json:"id"`
+	// Name The name of the workflow
+	Name string `json:"name"`
+	// Scope ['ACCOUNT' or 'GROUP' or 'OTHER']: The scope of the workflow
+	Scope string `json:"scope,omitempty"`
+	// ScopeId The unique identifier of the account or group the workflow belongs to
+	ScopeId string `json:"scopeId,omitempty"`
+	// Status ['ACTIVE' or 'DRAFT' or 'INACTIVE']: The status of the workflow
+	Status string `json:"status,omitempty"`
+}
+
+type UserWorkflows struct {
+	// UserWorkflowList An array of workflows
+	UserWorkflowList []UserWorkflow `json:"userWorkflowList"`
+}
+
+// WorkflowListOptions specifies the optional parameters of ListWorkflows.
+type WorkflowListOptions struct {
+	// IncludeDraftWorkflows Also returns the workflows in the DRAFT status
+	IncludeDraftWorkflows bool `url:"includeDraftWorkflows,omitempty"`
+	// IncludeInactiveWorkflows Also returns the workflows in the INACTIVE status
+	IncludeInactiveWorkflows bool `url:"includeInactiveWorkflows,omitempty"`
+	// GroupId Only returns the workflows of this group
+	GroupId string `url:"groupId,omitempty"`
+}
+
+type WorkflowFieldInfo struct {
+	// DefaultValue The default value of the field
+	DefaultValue string `json:"defaultValue,omitempty"`
+	// Editable True if the default value can be changed
+	Editable bool `json:"editable"`
+	// Required True if a value must be provided
+	Required bool `json:"required"`
+	// Visible True if the field is shown to the sender
+	Visible bool `json:"visible"`
+}
+
+type WorkflowRecipientsListInfo struct {
+	// AllowFax True if a fax number can be provided instead of an email
+	AllowFax bool `json:"allowfax,omitempty"`
+	// AllowSender True if the sender can be a recipient of this list
+	AllowSender bool `json:"allowSender,omitempty"`
+	// AuthenticationMethod The authentication method required from the recipients
+	AuthenticationMethod string `json:"authenticationMethod,omitempty"`
+	// DefaultValue Comma separated emails of the default recipients
+	DefaultValue string `json:"defaultValue,omitempty"`
+	// Editable True if the default recipients can be changed
+	Editable bool `json:"editable"`
+	// Label The label of the recipient list as displayed to the sender
+	Label string `json:"label,omitempty"`
+	// MaxListCount The maximum number of recipients in the list
+	MaxListCount int `json:"maxListCount"`
+	// MinListCount The minimum number of recipients in the list
+	MinListCount int `json:"minListCount"`
+	// Name The name of the recipient list, used as the label of the matching ParticipantSetInfo
+	Name string `json:"name"`
+	// Role The role of the recipients, see ParticipantRole
+	Role string `json:"role"`
+	// Visible True if the recipient list is shown to the sender
+	Visible bool `json:"visible"`
+}
+
+type WorkflowCcsListInfo struct {
+	// DefaultValues The emails of the default CCs
+	DefaultValues []string `json:"defaultValues,omitempty"`
+	// Editable True if the default CCs can be changed
+	Editable bool `json:"editable"`
+	// Label The label of the CC list as displayed to the sender
+	Label string `json:"label,omitempty"`
+	// MaxListCount The maximum number of CCs in the list
+	MaxListCount int `json:"maxListCount"`
+	// MinListCount The minimum number of CCs in the list
+	MinListCount int `json:"minListCount"`
+	// Name The name of the CC list, used as the label of the matching Cc
+	Name string `json:"name"`
+	// Visible True if the CC list is shown to the sender
+	Visible bool `json:"visible"`
+}
+
+type WorkflowLibraryDocument struct {
+	// Label The label of the library document as displayed to the sender
+	Label string `json:"label,omitempty"`
+	// WorkflowLibDoc The unique identifier of the library document
+	WorkflowLibDoc string `json:"workflowLibDoc"`
+}
+
+type WorkflowFileInfo struct {
+	// Label The label of the file as displayed to the sender
+	Label string `json:"label,omitempty"`
+	// Name The name of the file, used as the label of the matching FileInfo
+	Name string `json:"name"`
+	// Required True if the file must be provided
+	Required bool `json:"required"`
+	// WorkflowLibraryDocumentSelectorList The library documents that can be used for this file
+	WorkflowLibraryDocumentSelectorList []WorkflowLibraryDocument `json:"workflowLibraryDocumentSelectorList,omitempty"`
+}
+
+type WorkflowMergeFieldInfo struct {
+	// DefaultValue The default value of the field
+	DefaultValue string `json:"defaultValue,omitempty"`
+	// DisplayName The name of the field as displayed to the sender
+	DisplayName string `json:"displayName,omitempty"`
+	// Editable True if the default value can be changed
+	Editable bool `json:"editable"`
+	// FieldName The name of the form field
+	FieldName string `json:"fieldName"`
+	// Required True if a value must be provided
+	Required bool `json:"required"`
+	// Visible True if the field is shown to the sender
+	Visible bool `json:"visible"`
+}
+
+type WorkflowExpirationInfo struct {
+	// DefaultValue The default number of days after which the agreement expires
+	DefaultValue int `json:"defaultValue,omitempty"`
+	// Editable True if the expiration can be changed
+	Editable bool `json:"editable"`
+	// MaxDays The maximum number of days after which the agreement expires
+	MaxDays int `json:"maxDays,omitempty"`
+	// Visible True if the expiration is shown to the sender
+	Visible bool `json:"visible"`
+}
+
+// Workflow describes a custom workflow and the constraints agreements sent through it must meet
+// ref: https://secure.na1.adobesign.com/public/docs/restapi/v6#!/workflows/getWorkflowInfo
+type Workflow struct {
+	// AgreementNameInfo Constraints of the agreement name
+	AgreementNameInfo WorkflowFieldInfo `json:"agreementNameInfo"`
+	// AuthoringInfo Whether the agreement is authored before being sent
+	AuthoringInfo WorkflowFieldInfo `json:"authoringInfo"`
+	// CcsListInfo Constraints of the CC lists
+	CcsListInfo []WorkflowCcsListInfo `json:"ccsListInfo,omitempty"`
+	// Created The date on which the workflow was created
+	Created string `json:"created,omitempty"`
+	// Description The description of the workflow
+	Description string `json:"description,omitempty"`
+	// DisplayName The name of the workflow as displayed to the user
+	DisplayName string `json:"displayName,omitempty"`
+	// ExpirationInfo Constraints of the expiration of the agreement
+	ExpirationInfo WorkflowExpirationInfo `json:"expirationInfo"`
+	// FileInfos The files of the agreement
+	FileInfos []WorkflowFileInfo `json:"fileInfos,omitempty"`
+	// Id The unique identifier of the workflow
+	Id string `json:"id"`
+	// LocaleInfo Constraints of the locale of the agreement
+	LocaleInfo WorkflowFieldInfo `json:"localeInfo"`
+	// MergeFieldsInfo The merge fields of the agreement
+	MergeFieldsInfo []WorkflowMergeFieldInfo `json:"mergeFieldsInfo,omitempty"`
+	// MessageInfo Constraints of the agreement message
+	MessageInfo WorkflowFieldInfo `json:"messageInfo"`
+	// Modified The date on which the workflow was last modified
+	Modified string `json:"modified,omitempty"`
+	// Name The name of the workflow
+	Name string `json:"name"`
+	// RecipientsListInfo The recipient lists of the agreement, each one becomes a participant set
+	RecipientsListInfo []WorkflowRecipientsListInfo `json:"recipientsListInfo"`
+	// Scope ['ACCOUNT' or 'GROUP' or 'OTHER']: The scope of the workflow
+	Scope string `json:"scope,omitempty"`
+	// ScopeId The unique identifier of the account or group the workflow belongs to
+	ScopeId string `json:"scopeId,omitempty"`
+	// Status ['ACTIVE' or 'DRAFT' or 'INACTIVE']: The status of the workflow
+	Status string `json:"status,omitempty"`
+}
+
+// WorkflowValidationError occurs when an agreement does not meet the constraints of the workflow it is sent through.
+type WorkflowValidationError struct {
+	WorkflowId string
+	Problems   []string
+}
+
+func (e *WorkflowValidationError) Error() string {
+	return fmt.Sprintf("agreement does not match workflow %s: %s", e.WorkflowId, strings.Join(e.Problems, "; "))
+}
+
+// agreementTimeLayout is the layout of the dates used by Adobe Sign, yyyy-MM-dd'T'HH:mm:ssZ.
+const agreementTimeLayout = "2006-01-02T15:04:05Z0700"
+
+// ListWorkflows retrieves the workflows available to the calling user
+// ref: https://secure.na1.adobesign.com/public/docs/restapi/v6#!/workflows/getWorkflows
+func (s *WorkflowService) ListWorkflows(ctx context.Context, opts *WorkflowListOptions) ([]UserWorkflow, error) {
+	u, err := addOptions(workflowsPath, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var response UserWorkflows
+	if _, err := s.client.Do(ctx, req, &response); err != nil {
+		return nil, err
+	}
+
+	return response.UserWorkflowList, nil
+}
+
+// GetWorkflow retrieves an existing workflow and its constraints
+// ref: https://secure.na1.adobesign.com/public/docs/restapi/v6#!/workflows/getWorkflowInfo
+func (s *WorkflowService) GetWorkflow(ctx context.Context, workflowId string) (*Workflow, error) {
+	u := fmt.Sprintf("%s/%s", workflowsPath, workflowId)
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var response *Workflow
+	if _, err := s.client.Do(ctx, req, &response); err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+// CreateAgreementFromWorkflow builds an agreement from the defaults of a workflow, lets edit fill in the rest,
+// validates the result against the workflow and creates the agreement. Nothing is sent if edit returns an error or
+// the validation fails, in which case a *WorkflowValidationError is returned.
+func (s *WorkflowService) CreateAgreementFromWorkflow(ctx context.Context, workflowId string, edit func(agreement *Agreement) error) (*CreateAgreementResponse, error) {
+	workflow, err := s.GetWorkflow(ctx, workflowId)
+	if err != nil {
+		return nil, err
+	}
+
+	agreement := workflow.NewAgreement()
+	if err := edit(&agreement); err != nil {
+		return nil, err
+	}
+
+	if err := workflow.Validate(agreement); err != nil {
+		return nil, err
+	}
+
+	return (*AgreementService)(s).CreateAgreement(ctx, agreement)
+}
+
+// NewAgreement returns an agreement prefilled with the defaults of the workflow. Participant sets, CCs and files are
+// labeled with the names of the matching workflow lists.
+func (w Workflow) NewAgreement() Agreement {
+	agreement := Agreement{
+		WorkflowId:    w.Id,
+		Name:          w.AgreementNameInfo.DefaultValue,
+		Message:       w.MessageInfo.DefaultValue,
+		Locale:        w.LocaleInfo.DefaultValue,
+		SignatureType: SignatureType.Esign,
+		State:         AgreementState.InProcess,
+	}
+
+	if w.ExpirationInfo.DefaultValue > 0 {
+		agreement.ExpirationTime = time.Now().AddDate(0, 0, w.ExpirationInfo.DefaultValue).Format(agreementTimeLayout)
+	}
+
+	for i, list := range w.RecipientsListInfo {
+		set := ParticipantSetInfo{Label: list.Name, Role: list.Role, Order: i + 1}
+		for _, email := range splitEmails(list.DefaultValue) {
+			set.MemberInfos = append(set.MemberInfos, MemberInfo{Email: email})
+		}
+		agreement.ParticipantSetsInfo = append(agreement.ParticipantSetsInfo, set)
+	}
+
+	for _, list := range w.CcsListInfo {
+		for _, email := range list.DefaultValues {
+			agreement.Ccs = append(agreement.Ccs, Cc{Email: email, Label: list.Name})
+		}
+	}
+
+	for _, file := range w.FileInfos {
+		if len(file.WorkflowLibraryDocumentSelectorList) == 1 {
+			agreement.FileInfos = append(agreement.FileInfos, FileInfo{
+				Label:             file.Name,
+				LibraryDocumentId: file.WorkflowLibraryDocumentSelectorList[0].WorkflowLibDoc,
+			})
+		}
+	}
+
+	for _, field := range w.MergeFieldsInfo {
+		if field.DefaultValue != "" {
+			agreement.MergeFieldInfo = append(agreement.MergeFieldInfo, MergeFieldInfo{FieldName: field.FieldName, DefaultValue: field.DefaultValue})
+		}
+	}
+
+	return agreement
+}
+
+// Validate checks that agreement meets the constraints of the workflow and returns a *WorkflowValidationError
+// listing every problem found.
+func (w Workflow) Validate(agreement Agreement) error {
+	var problems []string
+
+	if agreement.WorkflowId != w.Id {
+		problems = append(problems, fmt.Sprintf("workflow id is %q", agreement.WorkflowId))
+	}
+	problems = append(problems, validateWorkflowField("name", w.AgreementNameInfo, agreement.Name)...)
+	problems = append(problems, validateWorkflowField("message", w.MessageInfo, agreement.Message)...)
+	problems = append(problems, validateWorkflowField("locale", w.LocaleInfo, agreement.Locale)...)
+
+	for _, list := range w.RecipientsListInfo {
+		count := 0
+		for _, set := range agreement.ParticipantSetsInfo {
+			if set.Label == list.Name {
+				count += len(set.MemberInfos)
+				if list.Role != "" && set.Role != list.Role {
+					problems = append(problems, fmt.Sprintf("recipients %q must have role %s", list.Name, list.Role))
+				}
+			}
+		}
+		problems = append(problems, validateWorkflowCount(fmt.Sprintf("recipients %q", list.Name), count, list.MinListCount, list.MaxListCount)...)
+	}
+
+	for _, list := range w.CcsListInfo {
+		count := 0
+		for _, cc := range agreement.Ccs {
+			if cc.Label == list.Name {
+				count++
+			}
+		}
+		problems = append(problems, validateWorkflowCount(fmt.Sprintf("ccs %q", list.Name), count, list.MinListCount, list.MaxListCount)...)
+	}
+
+	for _, file := range w.FileInfos {
+		if !file.Required {
+			continue
+		}
+		found := false
+		for _, info := range agreement.FileInfos {
+			if info.Label == file.Name {
+				found = true
+				break
+			}
+		}
+		if !found {
+			problems = append(problems, fmt.Sprintf("file %q is required", file.Name))
+		}
+	}
+
+	values := make(map[string]string, len(agreement.MergeFieldInfo))
+	for _, field := range agreement.MergeFieldInfo {
+		values[field.FieldName] = field.DefaultValue
+	}
+	for _, field := range w.MergeFieldsInfo {
+		value := values[field.FieldName]
+		if field.Required && value == "" {
+			problems = append(problems, fmt.Sprintf("merge field %q is required", field.FieldName))
+		}
+		if !field.Editable && value != "" && value != field.DefaultValue {
+			problems = append(problems, fmt.Sprintf("merge field %q cannot be changed", field.FieldName))
+		}
+	}
+
+	if w.ExpirationInfo.MaxDays > 0 && agreement.ExpirationTime != "" {
+		expiration, err := time.Parse(agreementTimeLayout, agreement.ExpirationTime)
+		if err != nil {
+			expiration, err = time.Parse(time.RFC3339, agreement.ExpirationTime)
+		}
+		if err != nil {
+			problems = append(problems, fmt.Sprintf("invalid expiration time %q", agreement.ExpirationTime))
+		} else if expiration.After(time.Now().AddDate(0, 0, w.ExpirationInfo.MaxDays)) {
+			problems = append(problems, fmt.Sprintf("expiration must be within %d days", w.ExpirationInfo.MaxDays))
+		}
+	}
+
+	if len(problems) > 0 {
+		return &WorkflowValidationError{WorkflowId: w.Id, Problems: problems}
+	}
+	return nil
+}
+
+// validateWorkflowField checks value against the constraints of a workflow field.
+func validateWorkflowField(name string, info WorkflowFieldInfo, value string) []string {
+	var problems []string
+	if info.Required && value == "" {
+		problems = append(problems, fmt.Sprintf("%s is required", name))
+	}
+	if !info.Editable && info.DefaultValue != "" && value != info.DefaultValue {
+		problems = append(problems, fmt.Sprintf("%s cannot be changed", name))
+	}
+	return problems
+}
+
+// validateWorkflowCount checks that count is within the bounds of a workflow list, a max of 0 means no limit.
+func validateWorkflowCount(name string, count, min, max int) []string {
+	if count < min {
+		return []string{fmt.Sprintf("%s needs at least %d entries, got %d", name, min, count)}
+	}
+	if max > 0 && count > max {
+		return []string{fmt.Sprintf("%s allows at most %d entries, got %d", name, max, count)}
+	}
+	return nil
+}
+
+// splitEmails splits the comma separated emails of a workflow default value.
+func splitEmails(value string) []string {
+	var emails []string
+	for _, email := range strings.Split(value, ",") {
+		if email = strings.TrimSpace(email); email != "" {
+			emails = append(emails, email)
+		}
+	}
+	return emails
+}
@@ -0,0 +1,176 @@
+package adobesign
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func testWorkflow() Workflow {
+	return Workflow{
+		Id:                "CBJCHBCAABAAbXh3Bh1C",
+		AgreementNameInfo: WorkflowFieldInfo{DefaultValue: "Service Agreement", Editable: true, Required: true},
+		MessageInfo:       WorkflowFieldInfo{DefaultValue: "Please sign", Editable: false},
+		LocaleInfo:        WorkflowFieldInfo{DefaultValue: "en_US", Editable: true},
+		RecipientsListInfo: []WorkflowRecipientsListInfo{
+			{Name: "Customer", Role: ParticipantRole.Signer, MinListCount: 1, MaxListCount: 2},
+			{Name: "Manager", Role: ParticipantRole.Approver, DefaultValue: "manager@example.com, ", MinListCount: 1, MaxListCount: 1},
+		},
+		CcsListInfo: []WorkflowCcsListInfo{
+			{Name: "Legal", DefaultValues: []string{"legal@example.com"}, MinListCount: 0, MaxListCount: 1},
+		},
+		FileInfos: []WorkflowFileInfo{
+			{Name: "Contract", Required: true, WorkflowLibraryDocumentSelectorList: []WorkflowLibraryDocument{{WorkflowLibDoc: "3AAABLblqZhB"}}},
+			{Name: "Appendix", Required: false},
+		},
+		MergeFieldsInfo: []WorkflowMergeFieldInfo{
+			{FieldName: "customer_name", Required: true, Editable: true},
+			{FieldName: "plan", DefaultValue: "basic", Editable: false},
+		},
+		ExpirationInfo: WorkflowExpirationInfo{DefaultValue: 7, MaxDays: 30},
+	}
+}
+
+// validWorkflowAgreement returns an agreement that passes the validation of testWorkflow.
+func validWorkflowAgreement(w Workflow) Agreement {
+	agreement := w.NewAgreement()
+	agreement.ParticipantSetsInfo[0].MemberInfos = []MemberInfo{{Email: "jane@example.com"}}
+	agreement.MergeFieldInfo = append(agreement.MergeFieldInfo, MergeFieldInfo{FieldName: "customer_name", DefaultValue: "Jane"})
+	return agreement
+}
+
+func TestWorkflowValidate(t *testing.T) {
+	tests := []struct {
+		name string
+		edit func(agreement *Agreement)
+		want []string
+	}{
+		{
+			name: "valid",
+			edit: func(agreement *Agreement) {},
+		},
+		{
+			name: "wrong workflow",
+			edit: func(agreement *Agreement) { agreement.WorkflowId = "other" },
+			want: []string{`workflow id is "other"`},
+		},
+		{
+			name: "required name",
+			edit: func(agreement *Agreement) { agreement.Name = "" },
+			want: []string{"name is required"},
+		},
+		{
+			name: "read only message",
+			edit: func(agreement *Agreement) { agreement.Message = "Sign now" },
+			want: []string{"message cannot be changed"},
+		},
+		{
+			name: "editable locale",
+			edit: func(agreement *Agreement) { agreement.Locale = "fr_FR" },
+		},
+		{
+			name: "too few recipients",
+			edit: func(agreement *Agreement) { agreement.ParticipantSetsInfo[0].MemberInfos = nil },
+			want: []string{`recipients "Customer" needs at least 1 entries, got 0`},
+		},
+		{
+			name: "too many recipients",
+			edit: func(agreement *Agreement) {
+				agreement.ParticipantSetsInfo[1].MemberInfos = append(agreement.ParticipantSetsInfo[1].MemberInfos, MemberInfo{Email: "ceo@example.com"})
+			},
+			want: []string{`recipients "Manager" allows at most 1 entries, got 2`},
+		},
+		{
+			name: "wrong recipient role",
+			edit: func(agreement *Agreement) { agreement.ParticipantSetsInfo[1].Role = ParticipantRole.Signer },
+			want: []string{`recipients "Manager" must have role APPROVER`},
+		},
+		{
+			name: "too many ccs",
+			edit: func(agreement *Agreement) {
+				agreement.Ccs = append(agreement.Ccs, Cc{Email: "audit@example.com", Label: "Legal"})
+			},
+			want: []string{`ccs "Legal" allows at most 1 entries, got 2`},
+		},
+		{
+			name: "required file",
+			edit: func(agreement *Agreement) { agreement.FileInfos = nil },
+			want: []string{`file "Contract" is required`},
+		},
+		{
+			name: "required merge field",
+			edit: func(agreement *Agreement) { agreement.MergeFieldInfo = agreement.MergeFieldInfo[:1] },
+			want: []string{`merge field "customer_name" is required`},
+		},
+		{
+			name: "read only merge field",
+			edit: func(agreement *Agreement) { agreement.MergeFieldInfo[0].DefaultValue = "premium" },
+			want: []string{`merge field "plan" cannot be changed`},
+		},
+		{
+			name: "expiration too late",
+			edit: func(agreement *Agreement) {
+				agreement.ExpirationTime = time.Now().AddDate(0, 0, 60).Format(time.RFC3339)
+			},
+			want: []string{"expiration must be within 30 days"},
+		},
+		{
+			name: "invalid expiration",
+			edit: func(agreement *Agreement) { agreement.ExpirationTime = "next week" },
+			want: []string{`invalid expiration time "next week"`},
+		},
+		{
+			name: "multiple problems",
+			edit: func(agreement *Agreement) {
+				agreement.Name = ""
+				agreement.FileInfos = nil
+			},
+			want: []string{"name is required", `file "Contract" is required`},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := testWorkflow()
+			agreement := validWorkflowAgreement(w)
+			tt.edit(&agreement)
+
+			err := w.Validate(agreement)
+			if tt.want == nil {
+				if err != nil {
+					t.Fatalf("Validate() error = %v, want nil", err)
+				}
+				return
+			}
+
+			var validationErr *WorkflowValidationError
+			if !errors.As(err, &validationErr) {
+				t.Fatalf("Validate() error = %v, want a *WorkflowValidationError", err)
+			}
+			if validationErr.WorkflowId != w.Id {
+				t.Errorf("WorkflowId = %q, want %q", validationErr.WorkflowId, w.Id)
+			}
+			if !reflect.DeepEqual(validationErr.Problems, tt.want) {
+				t.Errorf("Problems = %q, want %q", validationErr.Problems, tt.want)
+			}
+		})
+	}
+}
+
+func TestWorkflowNewAgreement(t *testing.T) {
+	agreement := testWorkflow().NewAgreement()
+
+	if agreement.WorkflowId != "CBJCHBCAABAAbXh3Bh1C" || agreement.Name != "Service Agreement" || agreement.Message != "Please sign" {
+		t.Errorf("NewAgreement() defaults = %q, %q, %q", agreement.WorkflowId, agreement.Name, agreement.Message)
+	}
+	if got := agreement.ParticipantSetsInfo[1].MemberInfos; !reflect.DeepEqual(got, []MemberInfo{{Email: "manager@example.com"}}) {
+		t.Errorf("NewAgreement() manager members = %+v", got)
+	}
+	if got := agreement.FileInfos; !reflect.DeepEqual(got, []FileInfo{{Label: "Contract", LibraryDocumentId: "3AAABLblqZhB"}}) {
+		t.Errorf("NewAgreement() files = %+v", got)
+	}
+	if got := agreement.MergeFieldInfo; !reflect.DeepEqual(got, []MergeFieldInfo{{FieldName: "plan", DefaultValue: "basic"}}) {
+		t.Errorf("NewAgreement() merge fields = %+v", got)
+	}
+}